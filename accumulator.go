@@ -0,0 +1,54 @@
+package calculator
+
+import "fmt"
+
+// Accumulator keeps running statistics over a stream of values in constant memory, without
+// storing the values themselves. The mean and variance are updated online with Welford's
+// algorithm, which avoids the cancellation errors of summing squares. The zero value is an empty
+// Accumulator ready to use.
+type Accumulator struct {
+	count int
+	sum   float64
+	mean  float64
+	// m2 is the running sum of squared deviations from the mean
+	m2 float64
+}
+
+// Push adds x to the accumulated values
+func (a *Accumulator) Push(x float64) {
+	a.count++
+	a.sum += x
+
+	delta := x - a.mean
+	a.mean += delta / float64(a.count)
+	a.m2 += delta * (x - a.mean)
+}
+
+// Count returns how many values have been pushed
+func (a *Accumulator) Count() int {
+	return a.count
+}
+
+// Sum returns the total of the values pushed so far, which is 0 when there are none
+func (a *Accumulator) Sum() float64 {
+	return a.sum
+}
+
+// Mean returns the mean of the values pushed so far or ErrEmptyInput if there are none
+func (a *Accumulator) Mean() (float64, error) {
+	if a.count == 0 {
+		return 0, ErrEmptyInput
+	}
+
+	return a.mean, nil
+}
+
+// Variance returns the sample variance of the values pushed so far, matching Variance. It needs at
+// least two values.
+func (a *Accumulator) Variance() (float64, error) {
+	if a.count < 2 {
+		return 0, fmt.Errorf("%w: sample variance needs at least 2 values, got %d", ErrInsufficientData, a.count)
+	}
+
+	return a.m2 / float64(a.count-1), nil
+}
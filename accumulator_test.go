@@ -0,0 +1,69 @@
+package calculator
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccumulator(t *testing.T) {
+	nums := []float64{2, 4, 4, 4, 5, 5, 7, 9, -3.5, 1e3}
+	acc := &Accumulator{}
+
+	for _, n := range nums {
+		acc.Push(n)
+	}
+
+	expectedMean, _ := Mean(nums...)
+	expectedVariance, _ := Variance(nums...)
+
+	mean, err := acc.Mean()
+	assert.NoError(t, err)
+	assert.InDelta(t, expectedMean, mean, 1e-9)
+
+	variance, err := acc.Variance()
+	assert.NoError(t, err)
+	assert.InDelta(t, expectedVariance, variance, 1e-9)
+
+	assert.Equal(t, len(nums), acc.Count())
+	assert.Equal(t, Sum(nums...), acc.Sum())
+}
+
+// Values with a large offset and a tiny spread are where naively summing squares falls apart
+func TestAccumulatorLargeOffset(t *testing.T) {
+	acc := &Accumulator{}
+	for _, n := range []float64{1e9 + 4, 1e9 + 7, 1e9 + 13, 1e9 + 16} {
+		acc.Push(n)
+	}
+
+	variance, err := acc.Variance()
+
+	assert.NoError(t, err)
+	assert.InDelta(t, 30, variance, 1e-6)
+}
+
+func TestAccumulatorEmpty(t *testing.T) {
+	var acc Accumulator
+
+	_, err := acc.Mean()
+	assert.Equal(t, ErrEmptyInput, err)
+
+	_, err = acc.Variance()
+	assert.True(t, errors.Is(err, ErrInsufficientData))
+
+	assert.Equal(t, 0, acc.Count())
+	assert.Equal(t, 0.0, acc.Sum())
+}
+
+func TestAccumulatorSingleValue(t *testing.T) {
+	var acc Accumulator
+	acc.Push(3)
+
+	mean, err := acc.Mean()
+	assert.NoError(t, err)
+	assert.Equal(t, 3.0, mean)
+
+	_, err = acc.Variance()
+	assert.True(t, errors.Is(err, ErrInsufficientData))
+}
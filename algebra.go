@@ -0,0 +1,76 @@
+package calculator
+
+import (
+	"fmt"
+	"math"
+)
+
+// SolveLinear returns the x that solves ax + b = 0, which is -b/a. When a is zero there's no x to
+// divide out, so the equation either has no solution, returning ErrNoSolution when b isn't zero,
+// or is solved by every x, returning ErrInfiniteSolutions when b is zero too. A zero solution is
+// always returned as positive zero.
+func SolveLinear(a, b float64) (float64, error) {
+	if a == 0 {
+		if b == 0 {
+			return 0, ErrInfiniteSolutions
+		}
+
+		return 0, ErrNoSolution
+	}
+
+	return positiveZero(-b / a), nil
+}
+
+// SolveQuadratic returns the real roots of ax² + bx + c = 0 in ascending order. There are two
+// roots when the discriminant b² - 4ac is positive, a single repeated root when it's zero and,
+// since complex roots aren't supported, an empty slice with no error when it's negative. A zero
+// root is always returned as positive zero. When a is zero the equation is linear and
+// ErrNotQuadratic is returned; use SolveLinear for those. NaN or infinite coefficients return
+// ErrNonFinite.
+func SolveQuadratic(a, b, c float64) ([]float64, error) {
+	for _, coefficient := range []float64{a, b, c} {
+		if math.IsNaN(coefficient) || math.IsInf(coefficient, 0) {
+			return nil, fmt.Errorf("%w: coefficient %g", ErrNonFinite, coefficient)
+		}
+	}
+	if a == 0 {
+		return nil, fmt.Errorf("%w: a is zero, use SolveLinear for bx + c = 0", ErrNotQuadratic)
+	}
+
+	// Scaling every coefficient by the same power of two leaves the roots unchanged, and bringing
+	// the largest one near 1 keeps b² - 4ac from overflowing. Powers of two scale exactly.
+	_, exp := math.Frexp(math.Max(math.Abs(a), math.Max(math.Abs(b), math.Abs(c))))
+	a, b, c = math.Ldexp(a, -exp), math.Ldexp(b, -exp), math.Ldexp(c, -exp)
+
+	discriminant := b*b - 4*a*c
+	if discriminant < 0 {
+		return []float64{}, nil
+	}
+	if discriminant == 0 {
+		return []float64{positiveZero(-b / (2 * a))}, nil
+	}
+
+	sqrt, err := SquareRoot(discriminant)
+	if err != nil {
+		return nil, err
+	}
+
+	// Adding the square root to b's magnitude rather than subtracting avoids cancellation when b²
+	// dwarfs 4ac; the other root then comes from the product of the roots being c/a
+	q := -(b + math.Copysign(sqrt, b)) / 2
+	x1, x2 := positiveZero(q/a), positiveZero(c/q)
+	if x1 > x2 {
+		x1, x2 = x2, x1
+	}
+
+	return []float64{x1, x2}, nil
+}
+
+// positiveZero returns x, turning -0 into 0 so that roots never display as -0
+func positiveZero(x float64) float64 {
+	if x == 0 {
+		return 0
+	}
+
+	return x
+}
@@ -0,0 +1,125 @@
+package calculator
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSolveLinear(t *testing.T) {
+	testCases := []struct {
+		name     string
+		a        float64
+		b        float64
+		expected float64
+	}{
+		{name: "Whole solution", a: 2, b: -8, expected: 4},
+		{name: "Fractional solution", a: 4, b: 1, expected: -0.25},
+		{name: "Negative coefficient", a: -3, b: 6, expected: 2},
+		{name: "Zero solution", a: 5, b: 0, expected: 0},
+		{name: "Zero solution with negative coefficient", a: -5, b: 0, expected: 0},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			actual, err := SolveLinear(tc.a, tc.b)
+
+			assert.NoError(tt, err)
+			assert.Equal(tt, tc.expected, actual)
+			assert.False(tt, math.Signbit(actual) && actual == 0, "expected positive zero")
+		})
+	}
+}
+
+func TestSolveLinearErrors(t *testing.T) {
+	_, err := SolveLinear(0, 3)
+	assert.Equal(t, ErrNoSolution, err)
+
+	_, err = SolveLinear(0, 0)
+	assert.Equal(t, ErrInfiniteSolutions, err)
+}
+
+func TestSolveQuadratic(t *testing.T) {
+	testCases := []struct {
+		name     string
+		a        float64
+		b        float64
+		c        float64
+		expected []float64
+	}{
+		{name: "Two roots", a: 1, b: -3, c: 2, expected: []float64{1, 2}},
+		{name: "Two roots with negative a", a: -2, b: 0, c: 8, expected: []float64{-2, 2}},
+		{name: "Root at zero", a: 1, b: 4, c: 0, expected: []float64{-4, 0}},
+		{name: "Fractional roots", a: 4, b: 0, c: -1, expected: []float64{-0.5, 0.5}},
+		{name: "Double root", a: 1, b: -4, c: 4, expected: []float64{2}},
+		{name: "Double root at zero", a: 3, b: 0, c: 0, expected: []float64{0}},
+		{name: "No real roots", a: 1, b: 0, c: 1, expected: []float64{}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			actual, err := SolveQuadratic(tc.a, tc.b, tc.c)
+
+			assert.NoError(tt, err)
+			assert.Equal(tt, tc.expected, actual)
+			for _, root := range actual {
+				assert.False(tt, math.Signbit(root) && root == 0, "expected positive zero")
+			}
+		})
+	}
+}
+
+// A small root next to a large one loses all its digits to cancellation in the textbook formula
+func TestSolveQuadraticPrecision(t *testing.T) {
+	roots, err := SolveQuadratic(1, 1e8, 1)
+
+	assert.NoError(t, err)
+	assert.Len(t, roots, 2)
+	assert.InEpsilon(t, -1e8, roots[0], 1e-15)
+	assert.InEpsilon(t, -1e-8, roots[1], 1e-15)
+}
+
+// Without scaling, b² and 4ac both overflow and their difference is NaN
+func TestSolveQuadraticLargeCoefficients(t *testing.T) {
+	roots, err := SolveQuadratic(1e200, 1e200, 1e199)
+
+	assert.NoError(t, err)
+	if assert.Len(t, roots, 2) {
+		// Dividing through by 1e200 gives x² + x + 0.1 = 0
+		assert.InEpsilon(t, (-1-math.Sqrt(0.6))/2, roots[0], 1e-12)
+		assert.InEpsilon(t, (-1+math.Sqrt(0.6))/2, roots[1], 1e-12)
+	}
+
+	roots, err = SolveQuadratic(1e-200, -3e-200, 2e-200)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []float64{1, 2}, roots)
+}
+
+func TestSolveQuadraticNonFinite(t *testing.T) {
+	testCases := []struct {
+		name string
+		a    float64
+		b    float64
+		c    float64
+	}{
+		{name: "NaN", a: 1, b: math.NaN(), c: 1},
+		{name: "Infinite a", a: math.Inf(1), b: 1, c: 1},
+		{name: "Infinite c", a: 1, b: 1, c: math.Inf(-1)},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			_, err := SolveQuadratic(tc.a, tc.b, tc.c)
+
+			assert.True(tt, errors.Is(err, ErrNonFinite), "expected ErrNonFinite, got %v", err)
+		})
+	}
+}
+
+func TestSolveQuadraticNotQuadratic(t *testing.T) {
+	roots, err := SolveQuadratic(0, 2, 1)
+
+	assert.True(t, errors.Is(err, ErrNotQuadratic), "expected ErrNotQuadratic, got %v", err)
+	assert.Contains(t, err.Error(), "SolveLinear")
+	assert.Nil(t, roots)
+}
@@ -0,0 +1,310 @@
+package calculator
+
+import (
+	"encoding/csv"
+	"io"
+	"math/big"
+	"strconv"
+)
+
+// Logger records the operations performed by a Calculator
+type Logger interface {
+	LogOp(op string, operands []float64, result float64)
+}
+
+// Option configures a Calculator
+type Option func(*Calculator)
+
+// WithLogger reports every operation performed by the Calculator to l. A nil Logger disables
+// logging.
+func WithLogger(l Logger) Option {
+	return func(c *Calculator) {
+		c.logger = l
+	}
+}
+
+// HistoryEntry records a single operation performed by a Calculator. Operand is the value the
+// operation was given, or for operations that don't take one, such as Apply, the value it was
+// applied to.
+type HistoryEntry struct {
+	Op      string
+	Operand float64
+	Result  float64
+}
+
+// WithVerifyStrategy sets the strategy Calculator.Verify uses to compare the current value with an
+// expected one. Without it values must match exactly.
+func WithVerifyStrategy(s VerifyStrategy) Option {
+	return func(c *Calculator) {
+		c.verifier = s
+	}
+}
+
+// Calculator applies operations to a running value, much like a physical calculator's display,
+// and keeps a history of every operation that changed it. It also has a separate memory register
+// that the Mem methods work with; those leave the current value alone and aren't recorded in the
+// history or reported to the Logger.
+type Calculator struct {
+	value   float64
+	memory  float64
+	history []HistoryEntry
+	logger  Logger
+	// observers are the callbacks registered with OnChange, in registration order
+	observers []func(op string, result float64)
+	// verifier is used by Verify, falling back to ExactVerify when nil
+	verifier VerifyStrategy
+	// scale is 10^places for a decimal calculator and nil for a plain float64 one
+	scale *big.Int
+}
+
+// NewCalculator returns a Calculator starting at zero, configured with any given options
+func NewCalculator(opts ...Option) *Calculator {
+	c := &Calculator{}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+// NewDecimalCalculator returns a Calculator that works in fixed-point decimal with the given number
+// of decimal places. This suits currency, where float64 drift such as 0.1 + 0.2 giving
+// 0.30000000000000004 isn't acceptable. Each operand is taken as the shortest decimal that formats
+// to it (so 0.1 means exactly one tenth), the operation is carried out exactly and the result is
+// rounded to places using banker's rounding, as with RoundHalfEven. Negative places are treated as
+// 0, as in FormatCurrency.
+func NewDecimalCalculator(places int, opts ...Option) *Calculator {
+	if places < 0 {
+		places = 0
+	}
+
+	c := NewCalculator(opts...)
+	c.scale = new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(places)), nil)
+
+	return c
+}
+
+// Value returns the current value of the calculator
+func (c *Calculator) Value() float64 {
+	return c.value
+}
+
+// Verify reports whether the current value matches want according to the calculator's
+// VerifyStrategy
+func (c *Calculator) Verify(want float64) bool {
+	if c.verifier == nil {
+		return ExactVerify{}.Verify(c.value, want)
+	}
+
+	return c.verifier.Verify(c.value, want)
+}
+
+// Add adds x to the current value
+func (c *Calculator) Add(x float64) {
+	c.set("add", []float64{c.value, x}, c.add(c.value, x))
+}
+
+// Sub subtracts x from the current value
+func (c *Calculator) Sub(x float64) {
+	c.set("sub", []float64{c.value, x}, c.sub(c.value, x))
+}
+
+// Mul multiplies the current value by x
+func (c *Calculator) Mul(x float64) {
+	c.set("mul", []float64{c.value, x}, c.mul(c.value, x))
+}
+
+// Div divides the current value by x. The current value is left unchanged if an error is returned.
+func (c *Calculator) Div(x float64) error {
+	result, err := c.div(c.value, x)
+	if err != nil {
+		return err
+	}
+
+	c.set("div", []float64{c.value, x}, result)
+
+	return nil
+}
+
+// Clear resets the current value to zero
+func (c *Calculator) Clear() {
+	c.set("clear", nil, 0)
+}
+
+// Apply replaces the current value with the result of calling fn on it, which lets callers add
+// their own operations. It's recorded in the history as "apply". If fn returns an error the
+// current value is left unchanged and the error is returned. In decimal mode the result is
+// rounded to the calculator's places like any other operation.
+func (c *Calculator) Apply(fn func(current float64) (float64, error)) error {
+	result, err := fn(c.value)
+	if err != nil {
+		return err
+	}
+
+	if c.scale != nil {
+		if rounded, ok := roundDecimal(result, c.scale); ok {
+			result = rounded
+		}
+	}
+
+	c.set("apply", []float64{c.value}, result)
+
+	return nil
+}
+
+// History returns every operation performed so far, oldest first
+func (c *Calculator) History() []HistoryEntry {
+	history := make([]HistoryEntry, len(c.history))
+	copy(history, c.history)
+
+	return history
+}
+
+// OnChange registers fn to be called after every operation that's recorded in the history, with
+// the operation's name and the new current value. Callbacks run in the order they were registered,
+// after the Logger. A nil fn is ignored.
+func (c *Calculator) OnChange(fn func(op string, result float64)) {
+	if fn == nil {
+		return
+	}
+
+	c.observers = append(c.observers, fn)
+}
+
+// HistoryCSV writes the history to w as CSV with a step,op,operand,result header followed by one
+// row per entry, oldest first. Steps are numbered from 1 and numbers are written in their shortest
+// exact form. Any error from writing to w is returned.
+func (c *Calculator) HistoryCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write([]string{"step", "op", "operand", "result"}); err != nil {
+		return err
+	}
+
+	for i, entry := range c.history {
+		record := []string{
+			strconv.Itoa(i + 1),
+			entry.Op,
+			strconv.FormatFloat(entry.Operand, 'g', -1, 64),
+			strconv.FormatFloat(entry.Result, 'g', -1, 64),
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+
+	return cw.Error()
+}
+
+// CalcState is a saved copy of a Calculator's value, memory and history, taken with Snapshot
+type CalcState struct {
+	value   float64
+	memory  float64
+	history []HistoryEntry
+}
+
+// Snapshot returns a copy of the calculator's current value, memory and history that Restore can
+// later roll back to. The snapshot is independent of the calculator, so operations performed
+// afterwards don't change it.
+func (c *Calculator) Snapshot() CalcState {
+	return CalcState{value: c.value, memory: c.memory, history: c.History()}
+}
+
+// Restore resets the calculator's value, memory and history to those saved in s. Configuration
+// such as the logger and decimal places is left alone, and the restore itself isn't recorded in
+// the history or reported to the Logger. The same snapshot can be restored any number of times.
+func (c *Calculator) Restore(s CalcState) {
+	c.value = s.value
+	c.memory = s.memory
+	c.history = make([]HistoryEntry, len(s.history))
+	copy(c.history, s.history)
+}
+
+// MemStore saves the current value into memory, replacing whatever was there
+func (c *Calculator) MemStore() {
+	c.memory = c.value
+}
+
+// MemAdd adds the current value to memory
+func (c *Calculator) MemAdd() {
+	c.memory = c.add(c.memory, c.value)
+}
+
+// MemRecall returns the value held in memory
+func (c *Calculator) MemRecall() float64 {
+	return c.memory
+}
+
+// MemClear resets memory to zero
+func (c *Calculator) MemClear() {
+	c.memory = 0
+}
+
+// set stores the result of an operation, records it in the history and reports it to the logger,
+// if there is one, and then to any observers. The last of operands is recorded as the history
+// entry's operand.
+func (c *Calculator) set(op string, operands []float64, result float64) {
+	c.value = result
+
+	var operand float64
+	if len(operands) > 0 {
+		operand = operands[len(operands)-1]
+	}
+	c.history = append(c.history, HistoryEntry{Op: op, Operand: operand, Result: result})
+
+	if c.logger != nil {
+		c.logger.LogOp(op, operands, result)
+	}
+
+	for _, fn := range c.observers {
+		fn(op, result)
+	}
+}
+
+// add, sub, mul and div perform arithmetic in whichever mode the calculator is in
+
+func (c *Calculator) add(x, y float64) float64 {
+	if c.scale != nil {
+		if result, ok := exactDecimal((*big.Rat).Add, x, y, c.scale); ok {
+			return result
+		}
+	}
+
+	return Add(x, y)
+}
+
+func (c *Calculator) sub(x, y float64) float64 {
+	if c.scale != nil {
+		if result, ok := exactDecimal((*big.Rat).Sub, x, y, c.scale); ok {
+			return result
+		}
+	}
+
+	return Subtract(x, y)
+}
+
+func (c *Calculator) mul(x, y float64) float64 {
+	if c.scale != nil {
+		if result, ok := exactDecimal((*big.Rat).Mul, x, y, c.scale); ok {
+			return result
+		}
+	}
+
+	return Multiply(x, y)
+}
+
+func (c *Calculator) div(x, y float64) (float64, error) {
+	result, err := Divide(x, y)
+	if err != nil {
+		return 0, err
+	}
+
+	if c.scale != nil {
+		if exact, ok := exactDecimal((*big.Rat).Quo, x, y, c.scale); ok {
+			return exact, nil
+		}
+	}
+
+	return result, nil
+}
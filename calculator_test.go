@@ -0,0 +1,392 @@
+package calculator
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeLogger captures every call made to it so that tests can assert against them
+type fakeLogger struct {
+	calls []loggedOp
+}
+
+type loggedOp struct {
+	op       string
+	operands []float64
+	result   float64
+}
+
+func (f *fakeLogger) LogOp(op string, operands []float64, result float64) {
+	f.calls = append(f.calls, loggedOp{op: op, operands: operands, result: result})
+}
+
+func TestCalculator(t *testing.T) {
+	c := NewCalculator()
+
+	c.Add(10)
+	c.Sub(4)
+	c.Mul(3)
+	err := c.Div(2)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 9.0, c.Value())
+}
+
+func TestCalculatorDivByZero(t *testing.T) {
+	c := NewCalculator()
+	c.Add(5)
+
+	err := c.Div(0)
+
+	assert.Equal(t, ErrDivideByZero, err)
+	assert.Equal(t, 5.0, c.Value())
+}
+
+func TestCalculatorWithLogger(t *testing.T) {
+	logger := &fakeLogger{}
+	c := NewCalculator(WithLogger(logger))
+
+	c.Add(2)
+	c.Mul(5)
+	c.Sub(1)
+	_ = c.Div(3)
+	_ = c.Div(0)
+	c.Clear()
+
+	expected := []loggedOp{
+		{op: "add", operands: []float64{0, 2}, result: 2},
+		{op: "mul", operands: []float64{2, 5}, result: 10},
+		{op: "sub", operands: []float64{10, 1}, result: 9},
+		{op: "div", operands: []float64{9, 3}, result: 3},
+		{op: "clear", result: 0},
+	}
+	assert.Equal(t, expected, logger.calls)
+}
+
+func TestCalculatorNilLogger(t *testing.T) {
+	c := NewCalculator(WithLogger(nil))
+
+	c.Add(1)
+
+	assert.Equal(t, 1.0, c.Value())
+}
+
+func TestCalculatorMemStoreRecall(t *testing.T) {
+	c := NewCalculator()
+	c.Add(42)
+
+	c.MemStore()
+	c.Clear()
+
+	assert.Equal(t, 42.0, c.MemRecall())
+	assert.Equal(t, 0.0, c.Value())
+}
+
+func TestCalculatorMemAdd(t *testing.T) {
+	c := NewCalculator()
+
+	c.Add(10)
+	c.MemAdd()
+	c.Sub(7)
+	c.MemAdd()
+	c.MemAdd()
+
+	assert.Equal(t, 16.0, c.MemRecall())
+	assert.Equal(t, 3.0, c.Value())
+}
+
+func TestCalculatorMemClear(t *testing.T) {
+	c := NewCalculator()
+	c.Add(5)
+	c.MemStore()
+
+	c.MemClear()
+
+	assert.Equal(t, 0.0, c.MemRecall())
+	assert.Equal(t, 5.0, c.Value())
+}
+
+func TestDecimalCalculator(t *testing.T) {
+	plain := NewCalculator()
+	decimal := NewDecimalCalculator(2)
+
+	for _, c := range []*Calculator{plain, decimal} {
+		c.Add(0.1)
+		c.Add(0.2)
+	}
+
+	// Plain float64 arithmetic gets this wrong
+	assert.NotEqual(t, 0.3, plain.Value())
+	assert.Equal(t, 0.3, decimal.Value())
+}
+
+func TestDecimalCalculatorOperations(t *testing.T) {
+	testCases := []struct {
+		name     string
+		places   int
+		ops      func(c *Calculator)
+		expected float64
+	}{
+		{name: "Repeated cents", places: 2, ops: func(c *Calculator) {
+			for i := 0; i < 10; i++ {
+				c.Add(0.1)
+			}
+		}, expected: 1},
+		{name: "Subtraction", places: 2, ops: func(c *Calculator) { c.Add(1.1); c.Sub(0.9) }, expected: 0.2},
+		{name: "Multiplication", places: 2, ops: func(c *Calculator) { c.Add(19.99); c.Mul(3) }, expected: 59.97},
+		{name: "Multiplication rounds", places: 2, ops: func(c *Calculator) { c.Add(10.25); c.Mul(0.07) }, expected: 0.72},
+		{name: "Division rounds half even down", places: 2, ops: func(c *Calculator) { c.Add(0.25); _ = c.Div(2) }, expected: 0.12},
+		{name: "Division rounds half even up", places: 2, ops: func(c *Calculator) { c.Add(0.75); _ = c.Div(2) }, expected: 0.38},
+		{name: "Division of thirds", places: 2, ops: func(c *Calculator) { c.Add(10); _ = c.Div(3) }, expected: 3.33},
+		{name: "Negative division", places: 2, ops: func(c *Calculator) { c.Sub(0.25); _ = c.Div(2) }, expected: -0.12},
+		{name: "Zero places", places: 0, ops: func(c *Calculator) { c.Add(7); _ = c.Div(2) }, expected: 4},
+		{name: "Negative places", places: -2, ops: func(c *Calculator) { c.Add(7); _ = c.Div(2) }, expected: 4},
+		{name: "Four places", places: 4, ops: func(c *Calculator) { c.Add(1); _ = c.Div(3) }, expected: 0.3333},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			c := NewDecimalCalculator(tc.places)
+
+			tc.ops(c)
+
+			assert.Equal(tt, tc.expected, c.Value())
+		})
+	}
+}
+
+func TestDecimalCalculatorDivByZero(t *testing.T) {
+	c := NewDecimalCalculator(2)
+	c.Add(1)
+
+	err := c.Div(0)
+
+	assert.Equal(t, ErrDivideByZero, err)
+	assert.Equal(t, 1.0, c.Value())
+}
+
+func TestDecimalCalculatorMemAdd(t *testing.T) {
+	c := NewDecimalCalculator(2)
+	c.Add(0.1)
+	c.MemAdd()
+	c.Clear()
+	c.Add(0.2)
+
+	c.MemAdd()
+
+	assert.Equal(t, 0.3, c.MemRecall())
+}
+
+func TestCalculatorApply(t *testing.T) {
+	c := NewCalculator()
+	c.Add(3)
+
+	err := c.Apply(func(current float64) (float64, error) {
+		return current * current, nil
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 9.0, c.Value())
+	assert.Equal(t, []HistoryEntry{
+		{Op: "add", Operand: 3, Result: 3},
+		{Op: "apply", Operand: 3, Result: 9},
+	}, c.History())
+}
+
+func TestCalculatorApplyError(t *testing.T) {
+	c := NewCalculator()
+	c.Sub(4)
+
+	err := c.Apply(func(current float64) (float64, error) {
+		return SquareRoot(current)
+	})
+
+	assert.Equal(t, ErrNegativeInput, err)
+	assert.Equal(t, -4.0, c.Value())
+	assert.Equal(t, []HistoryEntry{{Op: "sub", Operand: 4, Result: -4}}, c.History())
+}
+
+func TestCalculatorApplyLogged(t *testing.T) {
+	logger := &fakeLogger{}
+	c := NewCalculator(WithLogger(logger))
+	c.Add(2)
+
+	_ = c.Apply(func(current float64) (float64, error) { return current + 0.5, nil })
+
+	assert.Equal(t, loggedOp{op: "apply", operands: []float64{2}, result: 2.5}, logger.calls[1])
+}
+
+func TestDecimalCalculatorApply(t *testing.T) {
+	c := NewDecimalCalculator(2)
+	c.Add(2)
+
+	_ = c.Apply(func(current float64) (float64, error) { return SquareRoot(current) })
+
+	assert.Equal(t, 1.41, c.Value())
+}
+
+func TestCalculatorHistory(t *testing.T) {
+	c := NewCalculator()
+	c.Add(5)
+	c.Mul(3)
+	_ = c.Div(0)
+	_ = c.Div(5)
+	c.Clear()
+
+	history := c.History()
+
+	assert.Equal(t, []HistoryEntry{
+		{Op: "add", Operand: 5, Result: 5},
+		{Op: "mul", Operand: 3, Result: 15},
+		{Op: "div", Operand: 5, Result: 3},
+		{Op: "clear", Operand: 0, Result: 0},
+	}, history)
+
+	// The returned history is a copy
+	history[0].Op = "changed"
+	assert.Equal(t, "add", c.History()[0].Op)
+}
+
+func TestCalculatorHistoryCSV(t *testing.T) {
+	c := NewCalculator()
+	c.Add(5)
+	c.Mul(3)
+	c.Sub(0.5)
+	_ = c.Div(2)
+	c.Clear()
+
+	var buf bytes.Buffer
+	err := c.HistoryCSV(&buf)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"step,op,operand,result",
+		"1,add,5,5",
+		"2,mul,3,15",
+		"3,sub,0.5,14.5",
+		"4,div,2,7.25",
+		"5,clear,0,0",
+		"",
+	}, strings.Split(buf.String(), "\n"))
+}
+
+func TestCalculatorHistoryCSVEmpty(t *testing.T) {
+	var buf bytes.Buffer
+	err := NewCalculator().HistoryCSV(&buf)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "step,op,operand,result\n", buf.String())
+}
+
+// failingWriter rejects every write with err
+type failingWriter struct {
+	err error
+}
+
+func (f failingWriter) Write([]byte) (int, error) {
+	return 0, f.err
+}
+
+func TestCalculatorHistoryCSVWriteError(t *testing.T) {
+	writeErr := errors.New("disk full")
+	c := NewCalculator()
+	c.Add(1)
+
+	err := c.HistoryCSV(failingWriter{err: writeErr})
+
+	assert.True(t, errors.Is(err, writeErr), "expected the write error, got %v", err)
+}
+
+func TestCalculatorSnapshotRestore(t *testing.T) {
+	c := NewCalculator()
+	c.Add(5)
+	c.MemStore()
+	c.Mul(3)
+
+	snapshot := c.Snapshot()
+
+	c.Sub(20)
+	c.MemAdd()
+	_ = c.Div(2)
+
+	c.Restore(snapshot)
+
+	assert.Equal(t, 15.0, c.Value())
+	assert.Equal(t, 5.0, c.MemRecall())
+	assert.Equal(t, []HistoryEntry{
+		{Op: "add", Operand: 5, Result: 5},
+		{Op: "mul", Operand: 3, Result: 15},
+	}, c.History())
+}
+
+func TestCalculatorSnapshotIsIndependent(t *testing.T) {
+	c := NewCalculator()
+	c.Add(1)
+
+	snapshot := c.Snapshot()
+
+	// Operations after restoring must not leak into the snapshot, so it can be restored again
+	c.Restore(snapshot)
+	c.Add(2)
+	c.MemStore()
+	c.Restore(snapshot)
+
+	assert.Equal(t, 1.0, c.Value())
+	assert.Equal(t, 0.0, c.MemRecall())
+	assert.Equal(t, []HistoryEntry{{Op: "add", Operand: 1, Result: 1}}, c.History())
+}
+
+func TestCalculatorRestoreNotLogged(t *testing.T) {
+	logger := &fakeLogger{}
+	c := NewCalculator(WithLogger(logger))
+	snapshot := c.Snapshot()
+	c.Add(1)
+
+	c.Restore(snapshot)
+
+	assert.Len(t, logger.calls, 1)
+	assert.Equal(t, 0.0, c.Value())
+	assert.Empty(t, c.History())
+}
+
+// changeEvent is a call received by an OnChange callback
+type changeEvent struct {
+	op     string
+	result float64
+}
+
+func TestCalculatorOnChange(t *testing.T) {
+	var order []string
+	var first, second []changeEvent
+
+	c := NewCalculator()
+	c.OnChange(func(op string, result float64) {
+		order = append(order, "first")
+		first = append(first, changeEvent{op: op, result: result})
+	})
+	c.OnChange(nil)
+	c.OnChange(func(op string, result float64) {
+		order = append(order, "second")
+		second = append(second, changeEvent{op: op, result: result})
+	})
+
+	c.Add(5)
+	c.Mul(4)
+	_ = c.Div(0)
+	c.MemStore()
+	_ = c.Apply(func(current float64) (float64, error) { return current - 2, nil })
+	c.Clear()
+
+	expected := []changeEvent{
+		{op: "add", result: 5},
+		{op: "mul", result: 20},
+		{op: "apply", result: 18},
+		{op: "clear", result: 0},
+	}
+	assert.Equal(t, expected, first)
+	assert.Equal(t, expected, second)
+	assert.Equal(t, []string{"first", "second", "first", "second", "first", "second", "first", "second"}, order)
+}
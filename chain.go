@@ -0,0 +1,65 @@
+package calculator
+
+// Chain builds up a calculation one step at a time, such as New(10).Add(5).Mul(2).Result(). The
+// first step to fail stops the chain, turning every later step into a no-op, and its error is
+// reported by ResultErr.
+type Chain struct {
+	value float64
+	err   error
+}
+
+// New starts a Chain at x
+func New(x float64) *Chain {
+	return &Chain{value: x}
+}
+
+// Add adds x to the chain's value
+func (c *Chain) Add(x float64) *Chain {
+	if c.err == nil {
+		c.value = Add(c.value, x)
+	}
+
+	return c
+}
+
+// Sub subtracts x from the chain's value
+func (c *Chain) Sub(x float64) *Chain {
+	if c.err == nil {
+		c.value = Subtract(c.value, x)
+	}
+
+	return c
+}
+
+// Mul multiplies the chain's value by x
+func (c *Chain) Mul(x float64) *Chain {
+	if c.err == nil {
+		c.value = Multiply(c.value, x)
+	}
+
+	return c
+}
+
+// Div divides the chain's value by x, stopping the chain if x is zero
+func (c *Chain) Div(x float64) *Chain {
+	if c.err == nil {
+		value, err := Divide(c.value, x)
+		if err != nil {
+			c.err = err
+			return c
+		}
+		c.value = value
+	}
+
+	return c
+}
+
+// Result returns the chain's value. If a step failed, this is the value from just before it.
+func (c *Chain) Result() float64 {
+	return c.value
+}
+
+// ResultErr returns the chain's value along with the error from the first step that failed, if any
+func (c *Chain) ResultErr() (float64, error) {
+	return c.value, c.err
+}
@@ -0,0 +1,34 @@
+package calculator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChain(t *testing.T) {
+	actual, err := New(10).Add(5).Mul(2).Sub(3).Div(9).ResultErr()
+
+	assert.NoError(t, err)
+	assert.Equal(t, 3.0, actual)
+	assert.Equal(t, 27.0, New(10).Add(5).Mul(2).Sub(3).Result())
+}
+
+func TestChainDivideByZero(t *testing.T) {
+	chain := New(10).Add(5).Div(0)
+
+	actual, err := chain.ResultErr()
+
+	assert.Equal(t, ErrDivideByZero, err)
+	assert.Equal(t, 15.0, actual)
+}
+
+func TestChainSkipsStepsAfterError(t *testing.T) {
+	chain := New(8).Div(0).Add(1).Mul(100).Sub(2).Div(4)
+
+	actual, err := chain.ResultErr()
+
+	assert.Equal(t, ErrDivideByZero, err)
+	assert.Equal(t, 8.0, actual)
+	assert.Equal(t, 8.0, chain.Result())
+}
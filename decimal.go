@@ -0,0 +1,60 @@
+package calculator
+
+import (
+	"math/big"
+	"strconv"
+)
+
+// exactDecimal applies op to the decimal values of x and y and rounds the exact result to a
+// multiple of 1/scale using banker's rounding. It reports false if either operand isn't finite and
+// so has no decimal value.
+func exactDecimal(op func(z, x, y *big.Rat) *big.Rat, x, y float64, scale *big.Int) (float64, bool) {
+	rx, okx := decimalRat(x)
+	ry, oky := decimalRat(y)
+	if !okx || !oky {
+		return 0, false
+	}
+
+	result, _ := quantize(op(new(big.Rat), rx, ry), scale).Float64()
+
+	return result, true
+}
+
+// roundDecimal rounds the decimal value of x to a multiple of 1/scale using banker's rounding. It
+// reports false if x isn't finite.
+func roundDecimal(x float64, scale *big.Int) (float64, bool) {
+	r, ok := decimalRat(x)
+	if !ok {
+		return 0, false
+	}
+
+	result, _ := quantize(r, scale).Float64()
+
+	return result, true
+}
+
+// decimalRat returns the exact value of the shortest decimal that formats to x, which is what the
+// caller most likely wrote. For example 0.1 becomes exactly 1/10 rather than the binary
+// approximation a float64 actually holds.
+func decimalRat(x float64) (*big.Rat, bool) {
+	return new(big.Rat).SetString(strconv.FormatFloat(x, 'g', -1, 64))
+}
+
+// quantize rounds r to the nearest multiple of 1/scale, with ties going to the even multiple
+func quantize(r *big.Rat, scale *big.Int) *big.Rat {
+	n := new(big.Int).Mul(r.Num(), scale)
+	q, m := new(big.Int).QuoRem(n, r.Denom(), new(big.Int))
+
+	// QuoRem truncates toward zero, so compare twice the remainder against the denominator to see
+	// whether the dropped fraction was more than, less than or exactly one half
+	m.Abs(m).Lsh(m, 1)
+	if cmp := m.Cmp(r.Denom()); cmp > 0 || (cmp == 0 && q.Bit(0) == 1) {
+		if n.Sign() < 0 {
+			q.Sub(q, big.NewInt(1))
+		} else {
+			q.Add(q, big.NewInt(1))
+		}
+	}
+
+	return new(big.Rat).SetFrac(q, scale)
+}
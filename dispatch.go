@@ -0,0 +1,33 @@
+package calculator
+
+import "fmt"
+
+// operations are the operations Do can perform, keyed by name
+var operations = map[string]func(args ...float64) (float64, error){
+	"add":   binaryFunc(Add),
+	"sub":   binaryFunc(Subtract),
+	"mul":   binaryFunc(Multiply),
+	"div":   binaryErrFunc(Divide),
+	"abs":   unaryFunc(Abs),
+	"neg":   unaryFunc(Negate),
+	"recip": unaryErrFunc(Reciprocal),
+	"sqrt":  unaryErrFunc(SquareRoot),
+	"floor": unaryFunc(Floor),
+	"ceil":  unaryFunc(Ceil),
+	"trunc": unaryFunc(Trunc),
+}
+
+// Do performs the operation called op on args, giving a single entry point for callers that only
+// know the operation by name, such as a web handler. The binary operations "add", "sub", "mul" and
+// "div" take two arguments and the unary operations "abs", "neg", "recip", "sqrt", "floor", "ceil"
+// and "trunc" take one; any other count returns ErrArgumentCount. Unrecognized names return
+// ErrUnknownOperation and errors from the operation itself, such as ErrDivideByZero, are returned
+// as they are.
+func Do(op string, args ...float64) (float64, error) {
+	fn, ok := operations[op]
+	if !ok {
+		return 0, fmt.Errorf("%w: %q", ErrUnknownOperation, op)
+	}
+
+	return fn(args...)
+}
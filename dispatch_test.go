@@ -0,0 +1,62 @@
+package calculator
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDo(t *testing.T) {
+	testCases := []struct {
+		op       string
+		args     []float64
+		expected float64
+	}{
+		{op: "add", args: []float64{2, 3}, expected: 5},
+		{op: "sub", args: []float64{2, 3}, expected: -1},
+		{op: "mul", args: []float64{2, 3}, expected: 6},
+		{op: "div", args: []float64{3, 2}, expected: 1.5},
+		{op: "abs", args: []float64{-4}, expected: 4},
+		{op: "neg", args: []float64{4}, expected: -4},
+		{op: "recip", args: []float64{4}, expected: 0.25},
+		{op: "sqrt", args: []float64{16}, expected: 4},
+		{op: "floor", args: []float64{-1.5}, expected: -2},
+		{op: "ceil", args: []float64{-1.5}, expected: -1},
+		{op: "trunc", args: []float64{-1.5}, expected: -1},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.op, func(tt *testing.T) {
+			actual, err := Do(tc.op, tc.args...)
+
+			assert.NoError(tt, err)
+			assert.Equal(tt, tc.expected, actual)
+		})
+	}
+}
+
+func TestDoErrors(t *testing.T) {
+	testCases := []struct {
+		name     string
+		op       string
+		args     []float64
+		expected error
+	}{
+		{name: "Divide by zero", op: "div", args: []float64{4, 0}, expected: ErrDivideByZero},
+		{name: "Reciprocal of zero", op: "recip", args: []float64{0}, expected: ErrDivideByZero},
+		{name: "Negative square root", op: "sqrt", args: []float64{-1}, expected: ErrNegativeInput},
+		{name: "Too few for binary", op: "add", args: []float64{1}, expected: ErrArgumentCount},
+		{name: "Too many for binary", op: "mul", args: []float64{1, 2, 3}, expected: ErrArgumentCount},
+		{name: "None for unary", op: "abs", expected: ErrArgumentCount},
+		{name: "Too many for unary", op: "neg", args: []float64{1, 2}, expected: ErrArgumentCount},
+		{name: "Unknown", op: "pow", args: []float64{2, 3}, expected: ErrUnknownOperation},
+		{name: "Case sensitive", op: "ADD", args: []float64{2, 3}, expected: ErrUnknownOperation},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			_, err := Do(tc.op, tc.args...)
+
+			assert.True(tt, errors.Is(err, tc.expected), "expected %v, got %v", tc.expected, err)
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package calculator
+
+import "errors"
+
+// These are the sentinel errors returned by the package. Operations may wrap them with extra
+// context such as the offending input or position, so compare against them with errors.Is rather
+// than ==.
+var (
+	// ErrDivideByZero is returned when an operation would divide by zero
+	ErrDivideByZero = errors.New("calculator: divide by zero")
+	// ErrNegativeInput is returned when an operation isn't defined for negative values, such as a
+	// real square root
+	ErrNegativeInput = errors.New("calculator: input must not be negative")
+	// ErrNonPositiveInput is returned when an operation is only defined for values greater than
+	// zero
+	ErrNonPositiveInput = errors.New("calculator: input must be positive")
+	// ErrOverflow is returned when a result is too large to be represented
+	ErrOverflow = errors.New("calculator: overflow")
+	// ErrNonFinite is returned when a value is NaN or infinite where a finite number is required
+	ErrNonFinite = errors.New("calculator: value is not finite")
+	// ErrEmptyInput is returned when an operation needs at least one value but received none
+	ErrEmptyInput = errors.New("calculator: empty input")
+	// ErrOutOfRange is returned when a parameter falls outside the range an operation accepts
+	ErrOutOfRange = errors.New("calculator: value out of range")
+	// ErrInsufficientData is returned when an operation needs more values than it was given
+	ErrInsufficientData = errors.New("calculator: insufficient data")
+	// ErrNilInput is returned when a required slice is nil rather than merely empty
+	ErrNilInput = errors.New("calculator: nil input")
+	// ErrMalformedExpression is returned when a postfix expression passed to EvalRPN can't be
+	// evaluated, such as an operator without enough operands. Infix expressions passed to Eval
+	// report a *SyntaxError instead.
+	ErrMalformedExpression = errors.New("calculator: malformed expression")
+	// ErrArgumentCount is returned when a function is called with the wrong number of arguments
+	ErrArgumentCount = errors.New("calculator: wrong number of arguments")
+	// ErrUnknownOperation is returned when Do is asked for an operation it doesn't know
+	ErrUnknownOperation = errors.New("calculator: unknown operation")
+	// ErrReservedName is returned when registering a function under the name of a built-in
+	ErrReservedName = errors.New("calculator: name is reserved")
+	// ErrInvalidFunc is returned when registering a function with an unusable name or a nil body
+	ErrInvalidFunc = errors.New("calculator: invalid function")
+	// ErrLengthMismatch is returned when two inputs that must pair up element-wise differ in
+	// length
+	ErrLengthMismatch = errors.New("calculator: length mismatch")
+	// ErrUnsortedInput is returned when an operation requires its input in ascending order
+	ErrUnsortedInput = errors.New("calculator: input is not sorted")
+	// ErrZeroMAD is returned when every value sits on the median, leaving nothing to scale by
+	ErrZeroMAD = errors.New("calculator: median absolute deviation is zero")
+	// ErrZeroVariance is returned when a fit is impossible because the inputs don't vary
+	ErrZeroVariance = errors.New("calculator: zero variance")
+	// ErrNoSolution is returned when an equation has no solution, such as 0x + 1 = 0
+	ErrNoSolution = errors.New("calculator: no solution")
+	// ErrInfiniteSolutions is returned when every value solves an equation, such as 0x + 0 = 0
+	ErrInfiniteSolutions = errors.New("calculator: infinitely many solutions")
+	// ErrNotQuadratic is returned by SolveQuadratic when the squared term's coefficient is zero, in
+	// which case SolveLinear solves the equation instead
+	ErrNotQuadratic = errors.New("calculator: not a quadratic equation")
+	// ErrInvalidBase is returned when a base can't be used, such as a logarithm base of 1
+	ErrInvalidBase = errors.New("calculator: invalid base")
+	// ErrInvalidDigit is returned when a number contains a digit that isn't valid in its base
+	ErrInvalidDigit = errors.New("calculator: invalid digit")
+	// ErrUnitMismatch is returned when combining quantities measured in different units
+	ErrUnitMismatch = errors.New("calculator: unit mismatch")
+	// ErrSameSeparator is returned when a locale uses the same rune to group digits and to mark the
+	// decimal point, making numbers ambiguous
+	ErrSameSeparator = errors.New("calculator: grouping and decimal separators must differ")
+)
@@ -0,0 +1,92 @@
+package calculator
+
+import (
+	"errors"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Every operation that can fail should return an error that matches its sentinel with errors.Is,
+// no matter how much context it's been wrapped with
+func TestErrorsIs(t *testing.T) {
+	testCases := []struct {
+		name     string
+		run      func() error
+		expected error
+	}{
+		{name: "Divide", run: func() error { _, err := Divide(1, 0); return err }, expected: ErrDivideByZero},
+		{name: "DivMod", run: func() error { _, _, err := DivMod(1, 0); return err }, expected: ErrDivideByZero},
+		{name: "DivideRational", run: func() error { _, err := DivideRational(1, 0); return err }, expected: ErrDivideByZero},
+		{name: "DivideRational overflow", run: func() error { _, err := DivideRational(1, math.MinInt); return err }, expected: ErrOverflow},
+		{name: "Reciprocal", run: func() error { _, err := Reciprocal(0); return err }, expected: ErrDivideByZero},
+		{name: "Calculator Div", run: func() error { return NewCalculator().Div(0) }, expected: ErrDivideByZero},
+		{name: "Eval", run: func() error { _, err := Eval("4 / 0"); return err }, expected: ErrDivideByZero},
+		{name: "EvalRPN", run: func() error { _, err := EvalRPN([]string{"+"}); return err }, expected: ErrMalformedExpression},
+		{name: "EvalRPN divide", run: func() error { _, err := EvalRPN([]string{"1", "0", "/"}); return err }, expected: ErrDivideByZero},
+		{name: "AddInt", run: func() error { _, err := AddInt(math.MaxInt64, 1); return err }, expected: ErrOverflow},
+		{name: "SquareRoot", run: func() error { _, err := SquareRoot(-4); return err }, expected: ErrNegativeInput},
+		{name: "ParseNumber overflow", run: func() error { _, err := ParseNumber("1e400"); return err }, expected: ErrOverflow},
+		{name: "ParseNumber NaN", run: func() error { _, err := ParseNumber("NaN"); return err }, expected: ErrNonFinite},
+		{name: "ParseNumber Inf", run: func() error { _, err := ParseNumber("-Inf"); return err }, expected: ErrNonFinite},
+		{name: "ParseNumberLocale", run: func() error { _, err := ParseNumberLocale("1", '.', '.'); return err }, expected: ErrSameSeparator},
+		{name: "Mean", run: func() error { _, err := Mean(); return err }, expected: ErrEmptyInput},
+		{name: "SafeMean", run: func() error { _, err := SafeMean(); return err }, expected: ErrEmptyInput},
+		{name: "RobustZScores empty", run: func() error { _, err := RobustZScores(nil); return err }, expected: ErrEmptyInput},
+		{name: "RobustZScores zero MAD", run: func() error { _, err := RobustZScores([]float64{1, 1}); return err }, expected: ErrZeroMAD},
+		{name: "Percentile", run: func() error { _, err := Percentile(101, 1); return err }, expected: ErrOutOfRange},
+		{name: "Variance", run: func() error { _, err := Variance(1); return err }, expected: ErrInsufficientData},
+		{name: "StdDev", run: func() error { _, err := StdDev(); return err }, expected: ErrInsufficientData},
+		{name: "MovingAverage", run: func() error { _, err := MovingAverage([]float64{1}, 2); return err }, expected: ErrOutOfRange},
+		{name: "SnapTo empty", run: func() error { _, err := SnapTo(1, nil); return err }, expected: ErrEmptyInput},
+		{name: "SnapTo unsorted", run: func() error { _, err := SnapTo(1, []float64{2, 1}); return err }, expected: ErrUnsortedInput},
+		{name: "WeightedMean", run: func() error { _, err := WeightedMean([]float64{1}, nil); return err }, expected: ErrLengthMismatch},
+		{name: "WeightedStdDev", run: func() error { _, err := WeightedStdDev([]float64{1}, []float64{0}); return err }, expected: ErrDivideByZero},
+		{name: "SlopeThroughOrigin", run: func() error { _, err := SlopeThroughOrigin([]float64{0}, []float64{1}); return err }, expected: ErrZeroVariance},
+		{name: "CumulativeDistribution", run: func() error { _, err := CumulativeDistribution(nil); return err }, expected: ErrEmptyInput},
+		{name: "ManhattanDistance", run: func() error { _, err := ManhattanDistance([]float64{1}, nil); return err }, expected: ErrLengthMismatch},
+		{name: "ChebyshevDistance", run: func() error { _, err := ChebyshevDistance([]float64{1}, nil); return err }, expected: ErrLengthMismatch},
+		{name: "EvalBatch", run: func() error { _, err := EvalBatch(nil); return err }, expected: ErrNilInput},
+		{name: "AddQuantities", run: func() error { _, err := AddQuantities(Quantity{Unit: "m"}, Quantity{Unit: "s"}); return err }, expected: ErrUnitMismatch},
+		{name: "PrimeFactors", run: func() error { _, err := PrimeFactors(0); return err }, expected: ErrNonPositiveInput},
+		{name: "Totient", run: func() error { _, err := Totient(-1); return err }, expected: ErrNonPositiveInput},
+		{name: "HarmonicNumber", run: func() error { _, err := HarmonicNumber(0); return err }, expected: ErrNonPositiveInput},
+		{name: "Log", run: func() error { _, err := Log(0); return err }, expected: ErrNonPositiveInput},
+		{name: "Log10", run: func() error { _, err := Log10(-1); return err }, expected: ErrNonPositiveInput},
+		{name: "LogBase", run: func() error { _, err := LogBase(2, 1); return err }, expected: ErrInvalidBase},
+		{name: "ToBase", run: func() error { _, err := ToBase(1, 99); return err }, expected: ErrInvalidBase},
+		{name: "FromBase digit", run: func() error { _, err := FromBase("z", 10); return err }, expected: ErrInvalidDigit},
+		{name: "FromBase overflow", run: func() error { _, err := FromBase("zzzzzzzzzzzzzz", 36); return err }, expected: ErrOverflow},
+		{name: "Acosh", run: func() error { _, err := Acosh(0); return err }, expected: ErrOutOfRange},
+		{name: "Atanh", run: func() error { _, err := Atanh(1); return err }, expected: ErrOutOfRange},
+		{name: "Do unknown", run: func() error { _, err := Do("pow", 2, 3); return err }, expected: ErrUnknownOperation},
+		{name: "Do arguments", run: func() error { _, err := Do("add", 1); return err }, expected: ErrArgumentCount},
+		{name: "SolveLinear no solution", run: func() error { _, err := SolveLinear(0, 1); return err }, expected: ErrNoSolution},
+		{name: "SolveLinear infinite solutions", run: func() error { _, err := SolveLinear(0, 0); return err }, expected: ErrInfiniteSolutions},
+		{name: "SolveQuadratic", run: func() error { _, err := SolveQuadratic(0, 1, 1); return err }, expected: ErrNotQuadratic},
+		{name: "Chain", run: func() error { _, err := New(1).Div(0).ResultErr(); return err }, expected: ErrDivideByZero},
+		{name: "Sparkline", run: func() error { _, err := Sparkline(nil); return err }, expected: ErrEmptyInput},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			err := tc.run()
+
+			assert.True(tt, errors.Is(err, tc.expected), "expected %v, got %v", tc.expected, err)
+		})
+	}
+}
+
+// Wrapped errors should still read naturally, starting with the package prefix exactly once
+func TestErrorMessages(t *testing.T) {
+	_, err := ParseNumber("1e400")
+
+	assert.EqualError(t, err, `calculator: overflow: "1e400"`)
+	assert.Equal(t, 1, strings.Count(err.Error(), "calculator:"))
+
+	_, _, err = SumCSVColumn(strings.NewReader("1e400\n"), 0, false)
+
+	assert.EqualError(t, err, `calculator: row 1: overflow: "1e400"`)
+	assert.Equal(t, 1, strings.Count(err.Error(), "calculator:"))
+}
@@ -0,0 +1,392 @@
+package calculator
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// checkEvery is how many tokenizing or parsing steps EvalContext takes between checks of its
+// context, keeping cancellation prompt without paying for a check on every single step
+const checkEvery = 64
+
+// SyntaxError describes a malformed expression along with the 1-based column where the problem
+// was found
+type SyntaxError struct {
+	Column int
+	Msg    string
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("calculator: %s at column %d", e.Msg, e.Column)
+}
+
+// Eval evaluates an infix arithmetic expression such as "2 * (3 + 4)". Supported operators are
+// +, -, *, / and ^ (exponentiation), along with parentheses and unary minus. Functions can be
+// called as name(args...), using either a built-in such as sqrt, abs, log, log10, sin, cos, tan,
+// floor, ceil and trunc or one added with RegisterFunc.
+func Eval(expr string) (float64, error) {
+	return EvalContext(context.Background(), expr)
+}
+
+// EvalContext is like Eval but stops and returns ctx.Err() as soon as it notices that ctx has been
+// cancelled
+func EvalContext(ctx context.Context, expr string) (float64, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	tokens, err := tokenize(ctx, expr)
+	if err != nil {
+		return 0, err
+	}
+
+	p := &parser{ctx: ctx, tokens: tokens, end: len(expr) + 1}
+
+	result, err := p.expression()
+	if err != nil {
+		return 0, err
+	}
+
+	if p.pos < len(p.tokens) {
+		tok := p.tokens[p.pos]
+		return 0, &SyntaxError{Column: tok.col, Msg: fmt.Sprintf("unexpected %q", tok.text)}
+	}
+
+	return result, nil
+}
+
+// Result is the outcome of evaluating a single expression in a batch
+type Result struct {
+	Input string
+	Value float64
+	Err   error
+}
+
+// EvalBatch evaluates each expression independently so that one bad expression doesn't stop the
+// rest. Per-expression failures are reported in each Result's Err; the returned error is only set
+// when exprs itself is nil.
+func EvalBatch(exprs []string) ([]Result, error) {
+	if exprs == nil {
+		return nil, ErrNilInput
+	}
+
+	results := make([]Result, len(exprs))
+	for i, expr := range exprs {
+		value, err := Eval(expr)
+		results[i] = Result{Input: expr, Value: value, Err: err}
+	}
+
+	return results, nil
+}
+
+type tokenKind int
+
+const (
+	tokenNumber tokenKind = iota
+	tokenOperator
+	tokenLeftParen
+	tokenRightParen
+	tokenComma
+	tokenIdent
+)
+
+type token struct {
+	kind  tokenKind
+	text  string
+	value float64
+	col   int
+}
+
+// tokenize splits expr into tokens, checking ctx every checkEvery characters
+func tokenize(ctx context.Context, expr string) ([]token, error) {
+	var tokens []token
+
+	for i, steps := 0, 0; i < len(expr); steps++ {
+		if steps%checkEvery == 0 {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+		}
+
+		ch := expr[i]
+		switch {
+		case ch == ' ' || ch == '\t' || ch == '\n' || ch == '\r':
+			i++
+		case ch == '+' || ch == '-' || ch == '*' || ch == '/' || ch == '^':
+			tokens = append(tokens, token{kind: tokenOperator, text: string(ch), col: i + 1})
+			i++
+		case ch == '(':
+			tokens = append(tokens, token{kind: tokenLeftParen, text: "(", col: i + 1})
+			i++
+		case ch == ')':
+			tokens = append(tokens, token{kind: tokenRightParen, text: ")", col: i + 1})
+			i++
+		case ch == ',':
+			tokens = append(tokens, token{kind: tokenComma, text: ",", col: i + 1})
+			i++
+		case isIdentStart(ch):
+			end := i
+			for end < len(expr) && isIdentChar(expr[end]) {
+				end++
+			}
+
+			tokens = append(tokens, token{kind: tokenIdent, text: expr[i:end], col: i + 1})
+			i = end
+		case isDigit(ch) || ch == '.':
+			end := scanNumber(expr, i)
+			text := expr[i:end]
+
+			value, err := strconv.ParseFloat(text, 64)
+			if err != nil {
+				return nil, &SyntaxError{Column: i + 1, Msg: fmt.Sprintf("invalid number %q", text)}
+			}
+
+			tokens = append(tokens, token{kind: tokenNumber, text: text, value: value, col: i + 1})
+			i = end
+		default:
+			return nil, &SyntaxError{Column: i + 1, Msg: fmt.Sprintf("unexpected character %q", ch)}
+		}
+	}
+
+	return tokens, nil
+}
+
+// scanNumber returns the index just past the number starting at expr[start], including an
+// optional exponent such as "e-3"
+func scanNumber(expr string, start int) int {
+	i := start
+	for i < len(expr) && (isDigit(expr[i]) || expr[i] == '.') {
+		i++
+	}
+
+	if i < len(expr) && (expr[i] == 'e' || expr[i] == 'E') {
+		j := i + 1
+		if j < len(expr) && (expr[j] == '+' || expr[j] == '-') {
+			j++
+		}
+		if j < len(expr) && isDigit(expr[j]) {
+			for j < len(expr) && isDigit(expr[j]) {
+				j++
+			}
+			i = j
+		}
+	}
+
+	return i
+}
+
+func isDigit(ch byte) bool {
+	return ch >= '0' && ch <= '9'
+}
+
+// parser is a recursive descent parser that evaluates tokens as it goes. From lowest to highest
+// precedence the grammar is:
+//
+//	expression = term { ("+" | "-") term }
+//	term       = unary { ("*" | "/") unary }
+//	unary      = ("-" | "+") unary | power
+//	power      = primary [ "^" unary ]
+//	primary    = number | call | "(" expression ")"
+//	call       = ident "(" [ expression { "," expression } ] ")"
+type parser struct {
+	ctx    context.Context
+	tokens []token
+	pos    int
+	steps  int
+	// end is the column reported when the expression ends unexpectedly
+	end int
+}
+
+func (p *parser) expression() (float64, error) {
+	left, err := p.term()
+	if err != nil {
+		return 0, err
+	}
+
+	for p.peekOperator("+", "-") {
+		op := p.next()
+
+		right, err := p.term()
+		if err != nil {
+			return 0, err
+		}
+
+		if op.text == "+" {
+			left = Add(left, right)
+		} else {
+			left = Subtract(left, right)
+		}
+	}
+
+	return left, nil
+}
+
+func (p *parser) term() (float64, error) {
+	left, err := p.unary()
+	if err != nil {
+		return 0, err
+	}
+
+	for p.peekOperator("*", "/") {
+		op := p.next()
+
+		right, err := p.unary()
+		if err != nil {
+			return 0, err
+		}
+
+		if op.text == "*" {
+			left = Multiply(left, right)
+			continue
+		}
+
+		left, err = Divide(left, right)
+		if err != nil {
+			return 0, fmt.Errorf("%w at column %d", err, op.col)
+		}
+	}
+
+	return left, nil
+}
+
+func (p *parser) unary() (float64, error) {
+	if p.peekOperator("-", "+") {
+		op := p.next()
+
+		value, err := p.unary()
+		if err != nil {
+			return 0, err
+		}
+
+		if op.text == "-" {
+			return -value, nil
+		}
+
+		return value, nil
+	}
+
+	return p.power()
+}
+
+func (p *parser) power() (float64, error) {
+	base, err := p.primary()
+	if err != nil {
+		return 0, err
+	}
+
+	if !p.peekOperator("^") {
+		return base, nil
+	}
+	p.next()
+
+	exp, err := p.unary()
+	if err != nil {
+		return 0, err
+	}
+
+	return math.Pow(base, exp), nil
+}
+
+func (p *parser) primary() (float64, error) {
+	p.steps++
+	if p.steps%checkEvery == 0 {
+		if err := p.ctx.Err(); err != nil {
+			return 0, err
+		}
+	}
+
+	if p.pos >= len(p.tokens) {
+		return 0, &SyntaxError{Column: p.end, Msg: "unexpected end of expression"}
+	}
+
+	tok := p.next()
+	switch tok.kind {
+	case tokenNumber:
+		return tok.value, nil
+	case tokenLeftParen:
+		value, err := p.expression()
+		if err != nil {
+			return 0, err
+		}
+
+		if p.pos >= len(p.tokens) || p.tokens[p.pos].kind != tokenRightParen {
+			return 0, &SyntaxError{Column: tok.col, Msg: "unclosed parenthesis"}
+		}
+		p.next()
+
+		return value, nil
+	case tokenIdent:
+		return p.call(tok)
+	default:
+		return 0, &SyntaxError{Column: tok.col, Msg: fmt.Sprintf("unexpected %q", tok.text)}
+	}
+}
+
+// call evaluates the arguments of a call to the function named by ident and then calls it
+func (p *parser) call(ident token) (float64, error) {
+	fn, ok := lookupFunc(ident.text)
+	if !ok {
+		return 0, &SyntaxError{Column: ident.col, Msg: fmt.Sprintf("unknown function %q", ident.text)}
+	}
+
+	if p.pos >= len(p.tokens) || p.tokens[p.pos].kind != tokenLeftParen {
+		return 0, &SyntaxError{Column: ident.col, Msg: fmt.Sprintf("expected \"(\" after %q", ident.text)}
+	}
+	open := p.next()
+
+	var args []float64
+	if p.pos < len(p.tokens) && p.tokens[p.pos].kind == tokenRightParen {
+		p.next()
+	} else {
+		for {
+			arg, err := p.expression()
+			if err != nil {
+				return 0, err
+			}
+			args = append(args, arg)
+
+			if p.pos >= len(p.tokens) {
+				return 0, &SyntaxError{Column: open.col, Msg: "unclosed parenthesis"}
+			}
+
+			sep := p.next()
+			if sep.kind == tokenRightParen {
+				break
+			}
+			if sep.kind != tokenComma {
+				return 0, &SyntaxError{Column: sep.col, Msg: fmt.Sprintf("unexpected %q", sep.text)}
+			}
+		}
+	}
+
+	result, err := fn(args...)
+	if err != nil {
+		return 0, fmt.Errorf("%w in %s at column %d", err, ident.text, ident.col)
+	}
+
+	return result, nil
+}
+
+// peekOperator reports whether the next token is one of the given operators
+func (p *parser) peekOperator(ops ...string) bool {
+	if p.pos >= len(p.tokens) || p.tokens[p.pos].kind != tokenOperator {
+		return false
+	}
+
+	for _, op := range ops {
+		if p.tokens[p.pos].text == op {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (p *parser) next() token {
+	tok := p.tokens[p.pos]
+	p.pos++
+
+	return tok
+}
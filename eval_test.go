@@ -0,0 +1,120 @@
+package calculator
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEval(t *testing.T) {
+	testCases := []struct {
+		name     string
+		expr     string
+		expected float64
+	}{
+		{name: "Single number", expr: "42", expected: 42},
+		{name: "Precedence", expr: "2 + 3 * 4", expected: 14},
+		{name: "Parentheses", expr: "(2 + 3) * 4", expected: 20},
+		{name: "Left associative", expr: "10 - 4 - 3", expected: 3},
+		{name: "Division", expr: "7 / 2", expected: 3.5},
+		{name: "Unary minus", expr: "-3 + -(2 * 2)", expected: -7},
+		{name: "Power is right associative", expr: "2 ^ 3 ^ 2", expected: 512},
+		{name: "Power binds tighter than unary minus", expr: "-2 ^ 2", expected: -4},
+		{name: "Decimals and exponents", expr: "1.5e2 + .5", expected: 150.5},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			actual, err := Eval(tc.expr)
+
+			assert.NoError(tt, err)
+			assert.Equal(tt, tc.expected, actual)
+		})
+	}
+}
+
+func TestEvalSyntaxErrors(t *testing.T) {
+	testCases := []struct {
+		name   string
+		expr   string
+		column int
+	}{
+		{name: "Empty", expr: "", column: 1},
+		{name: "Trailing operator", expr: "1 +", column: 4},
+		{name: "Unexpected character", expr: "1 + x", column: 5},
+		{name: "Unclosed parenthesis", expr: "(1 + 2", column: 1},
+		{name: "Extra closing parenthesis", expr: "1 + 2)", column: 6},
+		{name: "Invalid number", expr: "1.2.3", column: 1},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			_, err := Eval(tc.expr)
+
+			var syntaxErr *SyntaxError
+			if assert.True(tt, errors.As(err, &syntaxErr), "expected a SyntaxError, got %v", err) {
+				assert.Equal(tt, tc.column, syntaxErr.Column)
+			}
+		})
+	}
+}
+
+func TestEvalDivideByZero(t *testing.T) {
+	_, err := Eval("1 / (2 - 2)")
+
+	assert.True(t, errors.Is(err, ErrDivideByZero))
+	assert.EqualError(t, err, "calculator: divide by zero at column 3")
+}
+
+func TestEvalContextCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	actual, err := EvalContext(ctx, "1 + 2")
+
+	assert.Equal(t, context.Canceled, err)
+	assert.Equal(t, 0.0, actual)
+}
+
+func TestEvalContextLongExpression(t *testing.T) {
+	expr := strings.Repeat("1 + ", 999) + "1"
+
+	actual, err := EvalContext(context.Background(), expr)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1000.0, actual)
+}
+
+func TestEvalBatch(t *testing.T) {
+	exprs := []string{"1 + 1", "2 *", "10 / 4", "1 / 0"}
+
+	results, err := EvalBatch(exprs)
+
+	assert.NoError(t, err)
+	if assert.Len(t, results, 4) {
+		assert.Equal(t, Result{Input: "1 + 1", Value: 2}, results[0])
+
+		assert.Equal(t, "2 *", results[1].Input)
+		var syntaxErr *SyntaxError
+		assert.True(t, errors.As(results[1].Err, &syntaxErr))
+
+		assert.Equal(t, Result{Input: "10 / 4", Value: 2.5}, results[2])
+
+		assert.Equal(t, "1 / 0", results[3].Input)
+		assert.True(t, errors.Is(results[3].Err, ErrDivideByZero))
+	}
+}
+
+func TestEvalBatchEmpty(t *testing.T) {
+	results, err := EvalBatch([]string{})
+
+	assert.NoError(t, err)
+	assert.Empty(t, results)
+}
+
+func TestEvalBatchNil(t *testing.T) {
+	_, err := EvalBatch(nil)
+
+	assert.Equal(t, ErrNilInput, err)
+}
@@ -0,0 +1,224 @@
+package calculator
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// ParseNumber parses a number written with comma digit grouping and a period decimal point, such
+// as "1,234.56". Surrounding whitespace is ignored. Numbers too large for a float64 return
+// ErrOverflow and the special values NaN and Inf return ErrNonFinite.
+func ParseNumber(s string) (float64, error) {
+	return ParseNumberLocale(s, ',', '.')
+}
+
+// ParseNumberLocale parses a number written with the given grouping and decimal separators, so
+// European style input such as "1.234,56" can be read with a grouping of '.' and a decimal of ','.
+// Grouping is optional, but where it's used it must split the whole number part into groups of
+// three digits after a leading group of one to three, so "1,2" and "1,,2" are rejected. Only
+// decimal notation is accepted, not the hexadecimal forms such as "0x1p3" that strconv allows.
+// Otherwise it behaves like ParseNumber.
+func ParseNumberLocale(s string, grouping, decimal rune) (float64, error) {
+	if grouping == decimal {
+		return 0, ErrSameSeparator
+	}
+
+	cleaned := strings.TrimSpace(s)
+	if !validGrouping(cleaned, grouping, decimal) || strings.ContainsAny(cleaned, "xXpP") {
+		return 0, fmt.Errorf("calculator: invalid number %q: %w", s, strconv.ErrSyntax)
+	}
+	cleaned = strings.ReplaceAll(cleaned, string(grouping), "")
+	if decimal != '.' {
+		// A '.' is only meaningful to ParseFloat as the decimal point, so one left over here can
+		// only be a stray character
+		if strings.ContainsRune(cleaned, '.') {
+			return 0, fmt.Errorf("calculator: invalid number %q: %w", s, strconv.ErrSyntax)
+		}
+		cleaned = strings.ReplaceAll(cleaned, string(decimal), ".")
+	}
+
+	n, err := strconv.ParseFloat(cleaned, 64)
+	if errors.Is(err, strconv.ErrRange) && math.IsInf(n, 0) {
+		return 0, fmt.Errorf("%w: %q", ErrOverflow, s)
+	}
+	if err != nil {
+		var numErr *strconv.NumError
+		if errors.As(err, &numErr) {
+			err = numErr.Err
+		}
+		return 0, fmt.Errorf("calculator: invalid number %q: %w", s, err)
+	}
+
+	// ParseFloat accepts spellings such as "NaN" and "-Inf", which aren't useful as user input
+	if math.IsNaN(n) || math.IsInf(n, 0) {
+		return 0, fmt.Errorf("%w: %q", ErrNonFinite, s)
+	}
+
+	return n, nil
+}
+
+// validGrouping reports whether any grouping separators in s fall only between groups of three
+// digits in the whole number part, which ends at the decimal separator or the exponent
+func validGrouping(s string, grouping, decimal rune) bool {
+	if !strings.ContainsRune(s, grouping) {
+		return true
+	}
+
+	whole := s
+	if i := strings.IndexRune(s, decimal); i >= 0 {
+		whole = s[:i]
+	} else if i := strings.IndexAny(s, "eE"); i >= 0 {
+		whole = s[:i]
+	}
+	if strings.ContainsRune(s[len(whole):], grouping) {
+		return false
+	}
+
+	whole = strings.TrimLeft(whole, "+-")
+	for i, group := range strings.Split(whole, string(grouping)) {
+		if group == "" || len(group) > 3 || (i > 0 && len(group) != 3) {
+			return false
+		}
+		for j := 0; j < len(group); j++ {
+			if !isDigit(group[j]) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// sparkBlocks are the characters used by Sparkline, from lowest to highest
+var sparkBlocks = []rune("▁▂▃▄▅▆▇█")
+
+// Sparkline renders data as a row of block characters scaled so that the smallest value is the
+// lowest block and the largest is the tallest. Data with no spread renders as a flat line of
+// mid-height blocks. NaN and infinite values can't be placed on the scale and return
+// ErrNonFinite.
+func Sparkline(data []float64) (string, error) {
+	if len(data) == 0 {
+		return "", ErrEmptyInput
+	}
+
+	for i, d := range data {
+		if math.IsNaN(d) || math.IsInf(d, 0) {
+			return "", fmt.Errorf("%w: value %g at index %d", ErrNonFinite, d, i)
+		}
+	}
+
+	lo, hi := data[0], data[0]
+	for _, d := range data[1:] {
+		lo = math.Min(lo, d)
+		hi = math.Max(hi, d)
+	}
+
+	top := float64(len(sparkBlocks) - 1)
+
+	// Between -MaxFloat64 and MaxFloat64 the spread overflows, so fall back to halving everything.
+	// Halving isn't done otherwise since a tiny spread could underflow to zero.
+	offset := func(d float64) float64 { return d - lo }
+	spread := hi - lo
+	if math.IsInf(spread, 0) {
+		offset = func(d float64) float64 { return d/2 - lo/2 }
+		spread = hi/2 - lo/2
+	}
+
+	var b strings.Builder
+	for _, d := range data {
+		level := len(sparkBlocks)/2 - 1
+		if spread > 0 {
+			level = int(math.Round(offset(d) / spread * top))
+		}
+		b.WriteRune(sparkBlocks[Clamp(level, 0, len(sparkBlocks)-1)])
+	}
+
+	return b.String(), nil
+}
+
+// FormatCurrency formats amount as money with the given symbol, rounded to places decimal places
+// and with commas grouping the thousands, such as "$1,234.50". Negative amounts put the sign before
+// the symbol, as in "-$1,234.50". Rounding is half-even applied to the amount's decimal form, so
+// 0.125 becomes 0.12 and 2.675 becomes 2.68 even though the float64 nearest 2.675 is slightly
+// below it. Negative places are treated as 0. Non-finite amounts are formatted as "NaN", "+Inf"
+// or "-Inf" with no symbol.
+func FormatCurrency(amount float64, symbol string, places int) string {
+	r, ok := decimalRat(amount)
+	if !ok {
+		return strconv.FormatFloat(amount, 'f', -1, 64)
+	}
+
+	if places < 0 {
+		places = 0
+	}
+	scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(places)), nil)
+	r = quantize(r, scale)
+
+	sign := ""
+	if r.Sign() < 0 {
+		sign = "-"
+		r.Neg(r)
+	}
+
+	digits := r.FloatString(places)
+	whole, frac := digits, ""
+	if i := strings.IndexByte(digits, '.'); i >= 0 {
+		whole, frac = digits[:i], digits[i:]
+	}
+
+	return sign + symbol + groupThousands(whole) + frac
+}
+
+// groupThousands inserts a comma between every group of three digits, counting from the right
+func groupThousands(digits string) string {
+	if len(digits) <= 3 {
+		return digits
+	}
+
+	var b strings.Builder
+	lead := len(digits) % 3
+	if lead > 0 {
+		b.WriteString(digits[:lead])
+	}
+	for i := lead; i < len(digits); i += 3 {
+		if b.Len() > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteString(digits[i : i+3])
+	}
+
+	return b.String()
+}
+
+// ToBase formats n in the given base, which must be between 2 and 36. Digits above 9 use the
+// lowercase letters a to z and negative numbers get a leading minus, so ToBase(-255, 16) is "-ff".
+func ToBase(n int64, base int) (string, error) {
+	if base < 2 || base > 36 {
+		return "", fmt.Errorf("%w: %d is not between 2 and 36", ErrInvalidBase, base)
+	}
+
+	return strconv.FormatInt(n, base), nil
+}
+
+// FromBase parses s as a number in the given base, which must be between 2 and 36. Letters may be
+// either case and a leading minus or plus sign is allowed. Numbers that don't fit in an int64
+// return ErrOverflow.
+func FromBase(s string, base int) (int64, error) {
+	if base < 2 || base > 36 {
+		return 0, fmt.Errorf("%w: %d is not between 2 and 36", ErrInvalidBase, base)
+	}
+
+	n, err := strconv.ParseInt(s, base, 64)
+	switch {
+	case errors.Is(err, strconv.ErrRange):
+		return 0, fmt.Errorf("%w: %q in base %d", ErrOverflow, s, base)
+	case err != nil:
+		return 0, fmt.Errorf("%w: %q in base %d", ErrInvalidDigit, s, base)
+	}
+
+	return n, nil
+}
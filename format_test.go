@@ -0,0 +1,245 @@
+package calculator
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseNumber(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    string
+		expected float64
+	}{
+		{name: "Integer", input: "42", expected: 42},
+		{name: "Decimal", input: "3.14", expected: 3.14},
+		{name: "Grouped", input: "1,234.56", expected: 1234.56},
+		{name: "Millions", input: "12,345,678", expected: 12345678},
+		{name: "Negative", input: "-1,000.5", expected: -1000.5},
+		{name: "Whitespace", input: "  7,000 \n", expected: 7000},
+		{name: "Explicit plus", input: "+1,000", expected: 1000},
+		{name: "Ungrouped thousands", input: "1234567.5", expected: 1234567.5},
+		{name: "Grouped with exponent", input: "1,500e-3", expected: 1.5},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			actual, err := ParseNumber(tc.input)
+
+			assert.NoError(tt, err)
+			assert.Equal(tt, tc.expected, actual)
+		})
+	}
+}
+
+func TestParseNumberInvalid(t *testing.T) {
+	inputs := []string{
+		"", "abc", "1.2.3", "12a", "--1", ",",
+		"1,,2", "1,2", ",123", "123,", "1234,567", "1,234,56", "1.5,000", "1e3,000",
+		"0x1p3", "0x10", "1p3",
+	}
+	for _, input := range inputs {
+		t.Run(strconv.Quote(input), func(tt *testing.T) {
+			_, err := ParseNumber(input)
+
+			if assert.Error(tt, err) {
+				assert.Contains(tt, err.Error(), strconv.Quote(input))
+				assert.True(tt, errors.Is(err, strconv.ErrSyntax))
+			}
+		})
+	}
+}
+
+func TestParseNumberLocale(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    string
+		grouping rune
+		decimal  rune
+		expected float64
+	}{
+		{name: "European", input: "1.234,56", grouping: '.', decimal: ',', expected: 1234.56},
+		{name: "European negative", input: "-0,5", grouping: '.', decimal: ',', expected: -0.5},
+		{name: "Space grouping", input: "1 234 567,8", grouping: ' ', decimal: ',', expected: 1234567.8},
+		{name: "Apostrophe grouping", input: "1'000.25", grouping: '\'', decimal: '.', expected: 1000.25},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			actual, err := ParseNumberLocale(tc.input, tc.grouping, tc.decimal)
+
+			assert.NoError(tt, err)
+			assert.Equal(tt, tc.expected, actual)
+		})
+	}
+}
+
+func TestParseNumberLocaleInvalid(t *testing.T) {
+	_, err := ParseNumberLocale("1.5", ',', ',')
+	assert.Equal(t, ErrSameSeparator, err)
+
+	_, err = ParseNumberLocale("1,5.0", ' ', ',')
+	assert.True(t, errors.Is(err, strconv.ErrSyntax))
+
+	for _, input := range []string{"1.2", "1..234", "1.234,5.6", "12 34"} {
+		_, err = ParseNumberLocale(input, '.', ',')
+		assert.True(t, errors.Is(err, strconv.ErrSyntax), "expected ErrSyntax for %q, got %v", input, err)
+	}
+}
+
+func TestSparkline(t *testing.T) {
+	testCases := []struct {
+		name     string
+		data     []float64
+		expected string
+	}{
+		{name: "Ascending", data: []float64{0, 1, 2, 3, 4, 5, 6, 7}, expected: "▁▂▃▄▅▆▇█"},
+		{name: "Scaled", data: []float64{10, 80, 45}, expected: "▁█▅"},
+		{name: "Negative values", data: []float64{-1, 1, -1}, expected: "▁█▁"},
+		{name: "Constant", data: []float64{3, 3, 3}, expected: "▄▄▄"},
+		{name: "Single value", data: []float64{42}, expected: "▄"},
+		{name: "Full float64 range", data: []float64{-math.MaxFloat64, 0, math.MaxFloat64}, expected: "▁▅█"},
+		{name: "Subnormal spread", data: []float64{0, 5e-324}, expected: "▁█"},
+		{name: "Subnormal spread around zero", data: []float64{-5e-324, 0, 5e-324}, expected: "▁▅█"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			actual, err := Sparkline(tc.data)
+
+			assert.NoError(tt, err)
+			assert.Equal(tt, tc.expected, actual)
+		})
+	}
+}
+
+func TestSparklineEmpty(t *testing.T) {
+	_, err := Sparkline(nil)
+
+	assert.Equal(t, ErrEmptyInput, err)
+}
+
+func TestSparklineNonFinite(t *testing.T) {
+	testCases := []struct {
+		name string
+		data []float64
+	}{
+		{name: "Infinity", data: []float64{0, math.Inf(1)}},
+		{name: "Negative infinity", data: []float64{math.Inf(-1), 0}},
+		{name: "NaN", data: []float64{1, math.NaN(), 3}},
+		{name: "Only NaN", data: []float64{math.NaN()}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			_, err := Sparkline(tc.data)
+
+			assert.True(tt, errors.Is(err, ErrNonFinite), "expected ErrNonFinite, got %v", err)
+		})
+	}
+}
+
+func TestFormatCurrency(t *testing.T) {
+	testCases := []struct {
+		name     string
+		amount   float64
+		symbol   string
+		places   int
+		expected string
+	}{
+		{name: "Zero", amount: 0, symbol: "$", places: 2, expected: "$0.00"},
+		{name: "Pads places", amount: 1234.5, symbol: "$", places: 2, expected: "$1,234.50"},
+		{name: "Negative", amount: -1234.5, symbol: "$", places: 2, expected: "-$1,234.50"},
+		{name: "Large", amount: 9876543210.987, symbol: "€", places: 2, expected: "€9,876,543,210.99"},
+		{name: "Exactly three digits", amount: 999, symbol: "$", places: 2, expected: "$999.00"},
+		{name: "Four digits", amount: 1000, symbol: "$", places: 0, expected: "$1,000"},
+		{name: "Half even rounds down", amount: 0.125, symbol: "$", places: 2, expected: "$0.12"},
+		{name: "Half even rounds up", amount: 0.375, symbol: "$", places: 2, expected: "$0.38"},
+		{name: "Half even on .005 down", amount: 1.005, symbol: "$", places: 2, expected: "$1.00"},
+		{name: "Half even on .005 up", amount: 2.675, symbol: "$", places: 2, expected: "$2.68"},
+		{name: "Rounds into grouping", amount: 999.995, symbol: "$", places: 2, expected: "$1,000.00"},
+		{name: "Negative rounds to zero", amount: -0.004, symbol: "$", places: 2, expected: "$0.00"},
+		{name: "Multi-rune symbol", amount: 12.3, symbol: "CHF ", places: 2, expected: "CHF 12.30"},
+		{name: "Negative places", amount: 12.5, symbol: "$", places: -1, expected: "$12"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			assert.Equal(tt, tc.expected, FormatCurrency(tc.amount, tc.symbol, tc.places))
+		})
+	}
+}
+
+func TestFormatCurrencyRoundTrip(t *testing.T) {
+	formatted := FormatCurrency(-1234567.891, "", 2)
+
+	actual, err := ParseNumber(formatted)
+
+	assert.NoError(t, err)
+	assert.Equal(t, -1234567.89, actual)
+}
+
+func TestFormatCurrencyNonFinite(t *testing.T) {
+	assert.Equal(t, "NaN", FormatCurrency(math.NaN(), "$", 2))
+	assert.Equal(t, "-Inf", FormatCurrency(math.Inf(-1), "$", 2))
+}
+
+func TestToBaseAndFromBase(t *testing.T) {
+	testCases := []struct {
+		name string
+		n    int64
+		base int
+		s    string
+	}{
+		{name: "Binary", n: 10, base: 2, s: "1010"},
+		{name: "Octal", n: 64, base: 8, s: "100"},
+		{name: "Hex", n: 255, base: 16, s: "ff"},
+		{name: "Base 36", n: 1295, base: 36, s: "zz"},
+		{name: "Zero", n: 0, base: 7, s: "0"},
+		{name: "Negative", n: -255, base: 16, s: "-ff"},
+		{name: "Max int64", n: math.MaxInt64, base: 16, s: "7fffffffffffffff"},
+		{name: "Min int64", n: math.MinInt64, base: 2, s: "-1" + strings.Repeat("0", 63)},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			s, err := ToBase(tc.n, tc.base)
+			assert.NoError(tt, err)
+			assert.Equal(tt, tc.s, s)
+
+			n, err := FromBase(s, tc.base)
+			assert.NoError(tt, err)
+			assert.Equal(tt, tc.n, n)
+		})
+	}
+}
+
+func TestFromBaseUppercase(t *testing.T) {
+	n, err := FromBase("FF", 16)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(255), n)
+}
+
+func TestBaseErrors(t *testing.T) {
+	testCases := []struct {
+		name     string
+		run      func() error
+		expected error
+	}{
+		{name: "ToBase too small", run: func() error { _, err := ToBase(1, 1); return err }, expected: ErrInvalidBase},
+		{name: "ToBase too large", run: func() error { _, err := ToBase(1, 37); return err }, expected: ErrInvalidBase},
+		{name: "FromBase too small", run: func() error { _, err := FromBase("1", 0); return err }, expected: ErrInvalidBase},
+		{name: "FromBase too large", run: func() error { _, err := FromBase("1", 37); return err }, expected: ErrInvalidBase},
+		{name: "Digit outside base", run: func() error { _, err := FromBase("102", 2); return err }, expected: ErrInvalidDigit},
+		{name: "Letter outside base", run: func() error { _, err := FromBase("fg", 16); return err }, expected: ErrInvalidDigit},
+		{name: "Empty", run: func() error { _, err := FromBase("", 10); return err }, expected: ErrInvalidDigit},
+		{name: "Overflow", run: func() error { _, err := FromBase("8000000000000000", 16); return err }, expected: ErrOverflow},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			err := tc.run()
+
+			assert.True(tt, errors.Is(err, tc.expected), "expected %v, got %v", tc.expected, err)
+		})
+	}
+}
@@ -0,0 +1,128 @@
+package calculator
+
+import (
+	"fmt"
+	"sync"
+)
+
+// builtinFuncs are the functions Eval understands out of the box. Their names can't be taken by
+// RegisterFunc.
+var builtinFuncs = map[string]func(args ...float64) (float64, error){
+	"abs":   unaryFunc(Abs),
+	"sqrt":  unaryErrFunc(SquareRoot),
+	"log":   unaryErrFunc(Log),
+	"log10": unaryErrFunc(Log10),
+	"sin":   unaryFunc(Sin),
+	"cos":   unaryFunc(Cos),
+	"tan":   unaryFunc(Tan),
+	"floor": unaryFunc(Floor),
+	"ceil":  unaryFunc(Ceil),
+	"trunc": unaryFunc(Trunc),
+}
+
+// registeredFuncs holds the functions added with RegisterFunc
+var registeredFuncs = struct {
+	sync.RWMutex
+	m map[string]func(args ...float64) (float64, error)
+}{m: map[string]func(args ...float64) (float64, error){}}
+
+// RegisterFunc makes fn callable from Eval expressions as name(args...), for example registering
+// "max" allows "max(1, 2, 3)". fn receives however many arguments the expression passes, so it
+// should validate the count itself, returning an error wrapping ErrArgumentCount when it's wrong.
+// Registering a name again replaces the earlier function. Names must be identifiers made of
+// letters, digits and underscores that don't start with a digit, and can't be the name of a
+// built-in function such as "sqrt", which returns ErrReservedName.
+//
+// EvalRPN doesn't call functions since postfix notation can't tell how many operands a variadic
+// function should consume.
+func RegisterFunc(name string, fn func(args ...float64) (float64, error)) error {
+	if !isIdentifier(name) {
+		return fmt.Errorf("%w: %q is not a valid name", ErrInvalidFunc, name)
+	}
+	if fn == nil {
+		return fmt.Errorf("%w: %q has a nil function", ErrInvalidFunc, name)
+	}
+	if _, ok := builtinFuncs[name]; ok {
+		return fmt.Errorf("%w: %q", ErrReservedName, name)
+	}
+
+	registeredFuncs.Lock()
+	defer registeredFuncs.Unlock()
+
+	registeredFuncs.m[name] = fn
+
+	return nil
+}
+
+// lookupFunc finds the built-in or registered function called name
+func lookupFunc(name string) (func(args ...float64) (float64, error), bool) {
+	if fn, ok := builtinFuncs[name]; ok {
+		return fn, true
+	}
+
+	registeredFuncs.RLock()
+	defer registeredFuncs.RUnlock()
+
+	fn, ok := registeredFuncs.m[name]
+
+	return fn, ok
+}
+
+// unaryFunc adapts a single argument function so it can be called from Eval
+func unaryFunc(fn func(x float64) float64) func(args ...float64) (float64, error) {
+	return unaryErrFunc(func(x float64) (float64, error) {
+		return fn(x), nil
+	})
+}
+
+// unaryErrFunc adapts a single argument function that can fail so it can be called from Eval
+func unaryErrFunc(fn func(x float64) (float64, error)) func(args ...float64) (float64, error) {
+	return func(args ...float64) (float64, error) {
+		if len(args) != 1 {
+			return 0, fmt.Errorf("%w: expected 1, got %d", ErrArgumentCount, len(args))
+		}
+
+		return fn(args[0])
+	}
+}
+
+// binaryFunc adapts a two argument function so it can be called with a list of arguments
+func binaryFunc(fn func(x, y float64) float64) func(args ...float64) (float64, error) {
+	return binaryErrFunc(func(x, y float64) (float64, error) {
+		return fn(x, y), nil
+	})
+}
+
+// binaryErrFunc adapts a two argument function that can fail so it can be called with a list of
+// arguments
+func binaryErrFunc(fn func(x, y float64) (float64, error)) func(args ...float64) (float64, error) {
+	return func(args ...float64) (float64, error) {
+		if len(args) != 2 {
+			return 0, fmt.Errorf("%w: expected 2, got %d", ErrArgumentCount, len(args))
+		}
+
+		return fn(args[0], args[1])
+	}
+}
+
+func isIdentifier(name string) bool {
+	if name == "" || !isIdentStart(name[0]) {
+		return false
+	}
+
+	for i := 0; i < len(name); i++ {
+		if !isIdentChar(name[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isIdentStart(ch byte) bool {
+	return ch == '_' || (ch >= 'a' && ch <= 'z') || (ch >= 'A' && ch <= 'Z')
+}
+
+func isIdentChar(ch byte) bool {
+	return isIdentStart(ch) || isDigit(ch)
+}
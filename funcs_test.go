@@ -0,0 +1,122 @@
+package calculator
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// maxFunc is a variadic function for registering with Eval
+func maxFunc(args ...float64) (float64, error) {
+	if len(args) == 0 {
+		return 0, fmt.Errorf("%w: expected at least 1, got 0", ErrArgumentCount)
+	}
+
+	m := math.Inf(-1)
+	for _, a := range args {
+		m = math.Max(m, a)
+	}
+
+	return m, nil
+}
+
+func TestRegisterFunc(t *testing.T) {
+	err := RegisterFunc("double", func(args ...float64) (float64, error) {
+		if len(args) != 1 {
+			return 0, ErrArgumentCount
+		}
+		return args[0] * 2, nil
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, RegisterFunc("max", maxFunc))
+
+	testCases := []struct {
+		name     string
+		expr     string
+		expected float64
+	}{
+		{name: "Single argument", expr: "double(21)", expected: 42},
+		{name: "Variadic", expr: "max(1, 7, 3)", expected: 7},
+		{name: "Expression arguments", expr: "max(2 * 3, 10 - 1, -4)", expected: 9},
+		{name: "Nested calls", expr: "double(max(1, double(3)))", expected: 12},
+		{name: "Inside an expression", expr: "1 + double(2) * 3", expected: 13},
+		{name: "Built-in", expr: "sqrt(16) + abs(-1)", expected: 5},
+		{name: "Rounding built-ins", expr: "floor(2.7) + ceil(2.2) + trunc(-2.7)", expected: 3},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			actual, err := Eval(tc.expr)
+
+			assert.NoError(tt, err)
+			assert.Equal(tt, tc.expected, actual)
+		})
+	}
+}
+
+func TestRegisterFuncFunctionErrors(t *testing.T) {
+	assert.NoError(t, RegisterFunc("max", maxFunc))
+
+	_, err := Eval("1 + max()")
+	assert.True(t, errors.Is(err, ErrArgumentCount))
+	assert.EqualError(t, err, "calculator: wrong number of arguments: expected at least 1, got 0 in max at column 5")
+
+	_, err = Eval("sqrt(-1)")
+	assert.True(t, errors.Is(err, ErrNegativeInput))
+
+	_, err = Eval("sqrt(1, 2)")
+	assert.True(t, errors.Is(err, ErrArgumentCount))
+}
+
+func TestRegisterFuncRejected(t *testing.T) {
+	testCases := []struct {
+		name     string
+		funcName string
+		fn       func(args ...float64) (float64, error)
+		expected error
+	}{
+		{name: "Built-in", funcName: "sqrt", fn: maxFunc, expected: ErrReservedName},
+		{name: "Empty name", funcName: "", fn: maxFunc, expected: ErrInvalidFunc},
+		{name: "Leading digit", funcName: "2x", fn: maxFunc, expected: ErrInvalidFunc},
+		{name: "Operator in name", funcName: "a+b", fn: maxFunc, expected: ErrInvalidFunc},
+		{name: "Nil function", funcName: "nothing", fn: nil, expected: ErrInvalidFunc},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			err := RegisterFunc(tc.funcName, tc.fn)
+
+			assert.True(tt, errors.Is(err, tc.expected), "expected %v, got %v", tc.expected, err)
+		})
+	}
+
+	// The built-in must be untouched
+	actual, err := Eval("sqrt(9)")
+	assert.NoError(t, err)
+	assert.Equal(t, 3.0, actual)
+}
+
+func TestEvalFunctionSyntaxErrors(t *testing.T) {
+	testCases := []struct {
+		name   string
+		expr   string
+		column int
+	}{
+		{name: "Unknown function", expr: "1 + nope(2)", column: 5},
+		{name: "Missing parenthesis", expr: "sqrt 4", column: 1},
+		{name: "Unclosed call", expr: "abs(1", column: 4},
+		{name: "Missing separator", expr: "abs(1 2)", column: 7},
+		{name: "Trailing comma", expr: "abs(1,)", column: 7},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			_, err := Eval(tc.expr)
+
+			var syntaxErr *SyntaxError
+			if assert.True(tt, errors.As(err, &syntaxErr), "expected a SyntaxError, got %v", err) {
+				assert.Equal(tt, tc.column, syntaxErr.Column)
+			}
+		})
+	}
+}
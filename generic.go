@@ -0,0 +1,33 @@
+package calculator
+
+// Number is satisfied by every built-in integer and floating-point type, along with any type
+// defined in terms of one
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+// Clamp limits x to the range [lo, hi]. If lo is greater than hi the two are swapped, so the range
+// is always the span between them. A NaN x is returned unchanged.
+func Clamp[T Number](x, lo, hi T) T {
+	if lo > hi {
+		lo, hi = hi, lo
+	}
+
+	if x < lo {
+		return lo
+	}
+	if x > hi {
+		return hi
+	}
+
+	return x
+}
+
+// Lerp linearly interpolates between a and b, returning a when t is 0 and b when t is 1. Values of
+// t outside [0, 1] extrapolate along the same line rather than being clamped.
+func Lerp[T ~float32 | ~float64](a, b, t T) T {
+	// (1-t)*a + t*b rather than a + t*(b-a) so that t == 1 gives exactly b
+	return (1-t)*a + t*b
+}
@@ -0,0 +1,76 @@
+package calculator
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClampInt(t *testing.T) {
+	testCases := []struct {
+		name     string
+		x        int
+		lo       int
+		hi       int
+		expected int
+	}{
+		{name: "Within range", x: 5, lo: 0, hi: 10, expected: 5},
+		{name: "Below range", x: -3, lo: 0, hi: 10, expected: 0},
+		{name: "Above range", x: 12, lo: 0, hi: 10, expected: 10},
+		{name: "At bound", x: 10, lo: 0, hi: 10, expected: 10},
+		{name: "Lo above hi", x: 12, lo: 10, hi: 0, expected: 10},
+		{name: "Lo above hi below range", x: -3, lo: 10, hi: 0, expected: 0},
+		{name: "Empty range", x: 7, lo: 3, hi: 3, expected: 3},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			assert.Equal(tt, tc.expected, Clamp(tc.x, tc.lo, tc.hi))
+		})
+	}
+}
+
+func TestClampFloat(t *testing.T) {
+	testCases := []struct {
+		name     string
+		x        float64
+		lo       float64
+		hi       float64
+		expected float64
+	}{
+		{name: "Within range", x: 0.5, lo: 0, hi: 1, expected: 0.5},
+		{name: "Below range", x: -0.1, lo: 0, hi: 1, expected: 0},
+		{name: "Above range", x: 1.1, lo: 0, hi: 1, expected: 1},
+		{name: "Lo above hi", x: 1.1, lo: 1, hi: 0, expected: 1},
+		{name: "Infinity", x: math.Inf(-1), lo: -1, hi: 1, expected: -1},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			assert.Equal(tt, tc.expected, Clamp(tc.x, tc.lo, tc.hi))
+		})
+	}
+
+	assert.True(t, math.IsNaN(Clamp(math.NaN(), 0, 1)))
+}
+
+func TestLerp(t *testing.T) {
+	testCases := []struct {
+		name     string
+		a        float64
+		b        float64
+		t        float64
+		expected float64
+	}{
+		{name: "Start", a: 0, b: 10, t: 0, expected: 0},
+		{name: "Midpoint", a: 0, b: 10, t: 0.5, expected: 5},
+		{name: "End", a: 0, b: 10, t: 1, expected: 10},
+		{name: "Descending midpoint", a: 10, b: -10, t: 0.5, expected: 0},
+		{name: "End with fractions", a: 0.1, b: 0.7, t: 1, expected: 0.7},
+		{name: "Extrapolate", a: 0, b: 10, t: 1.5, expected: 15},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			assert.Equal(tt, tc.expected, Lerp(tc.a, tc.b, tc.t))
+		})
+	}
+}
@@ -1,5 +1,11 @@
 package calculator
 
+import (
+	"fmt"
+	"math"
+	"strconv"
+)
+
 // NumberCruncher runs calculations and verifications
 type NumberCruncher interface {
 	Add(x, y float64) float64
@@ -11,8 +17,283 @@ func Add(x, y float64) float64 {
 	return x + y
 }
 
+// precisionLossRatio is how many times larger one operand can be than the other before the smaller
+// one is effectively swallowed. float64 carries roughly 15-16 significant decimal digits.
+const precisionLossRatio = 1e15
+
+// AddInt sums two integers, returning ErrOverflow instead of silently wrapping around when the
+// result doesn't fit in an int64. Overflow is only possible when both operands have the same sign,
+// and shows up as a result whose sign differs from theirs.
+func AddInt(x, y int64) (int64, error) {
+	sum := x + y
+
+	if (x >= 0) == (y >= 0) && (sum >= 0) != (x >= 0) {
+		return 0, fmt.Errorf("%w: %d + %d", ErrOverflow, x, y)
+	}
+
+	return sum, nil
+}
+
+// AddWithPrecisionWarning sums two numbers like Add and also reports whether the result likely
+// lost precision because the operands' magnitudes are so far apart that the smaller one falls off
+// the end of the larger one's significant digits. For example, 1e16 + 1 returns 1e16 and true.
+// Adding zero never loses precision.
+func AddWithPrecisionWarning(x, y float64) (float64, bool) {
+	sum := Add(x, y)
+
+	if x == 0 || y == 0 {
+		return sum, false
+	}
+
+	hi, lo := math.Abs(x), math.Abs(y)
+	if lo > hi {
+		hi, lo = lo, hi
+	}
+
+	return sum, hi/lo > precisionLossRatio
+}
+
+// Subtract returns the difference of two numbers
+func Subtract(x, y float64) float64 {
+	return x - y
+}
+
+// Multiply returns the product of two numbers
+func Multiply(x, y float64) float64 {
+	return x * y
+}
+
+// Divide returns the quotient of two numbers or ErrDivideByZero if y is zero
+func Divide(x, y float64) (float64, error) {
+	if y == 0 {
+		return 0, ErrDivideByZero
+	}
+
+	return x / y, nil
+}
+
+// Reduce folds nums into a single value by applying op to a running accumulator and each number in
+// turn, starting from init. An empty nums returns init. For example, Reduce(1, Multiply, nums...)
+// is the product of nums.
+func Reduce(init float64, op func(acc, x float64) float64, nums ...float64) float64 {
+	acc := init
+	for _, n := range nums {
+		acc = op(acc, n)
+	}
+
+	return acc
+}
+
+// Sum adds up all of nums, returning 0 when there are none
+func Sum(nums ...float64) float64 {
+	return Reduce(0, Add, nums...)
+}
+
+// SumFast adds up nums like Sum but keeps four independent running totals, which lets the CPU
+// overlap the additions instead of waiting on each one in turn, and combines them at the end. Each
+// total only sees a quarter of the values, so rounding error also tends to be smaller. Because the
+// additions happen in a different order the result can differ from Sum's in the last few bits;
+// both are within n * 2^-53 * Sum(|nums|) of the exact sum.
+func SumFast(nums []float64) float64 {
+	var s0, s1, s2, s3 float64
+
+	i := 0
+	for ; i+4 <= len(nums); i += 4 {
+		s0 += nums[i]
+		s1 += nums[i+1]
+		s2 += nums[i+2]
+		s3 += nums[i+3]
+	}
+	for ; i < len(nums); i++ {
+		s0 += nums[i]
+	}
+
+	return (s0 + s1) + (s2 + s3)
+}
+
+// KahanSum adds up nums using Kahan-Babuška (Neumaier) compensated summation, which tracks the
+// low-order bits each addition rounds away and adds them back at the end. Prefer it over Sum when
+// adding many values of very different magnitudes, or values that cancel each other out, where
+// plain summation can lose some or all of the small values. For example Sum(1e16, 1, -1e16) is 0
+// while KahanSum returns 1. It costs a few extra operations per value.
+func KahanSum(nums ...float64) float64 {
+	var sum neumaierSum
+	for _, n := range nums {
+		sum.add(n)
+	}
+
+	return sum.value()
+}
+
+// CumSum returns the running totals of nums, where element i is the sum of nums[0] through
+// nums[i]. nums isn't modified, and empty input gives an empty, non-nil slice.
+func CumSum(nums []float64) []float64 {
+	sums := make([]float64, len(nums))
+
+	var sum float64
+	for i, n := range nums {
+		sum += n
+		sums[i] = sum
+	}
+
+	return sums
+}
+
+// CumSumKahan is like CumSum but keeps each running total compensated as KahanSum does, so totals
+// stay accurate when mixing magnitudes or cancelling values
+func CumSumKahan(nums []float64) []float64 {
+	sums := make([]float64, len(nums))
+
+	var sum neumaierSum
+	for i, n := range nums {
+		sum.add(n)
+		sums[i] = sum.value()
+	}
+
+	return sums
+}
+
+// neumaierSum is a running total using Kahan-Babuška (Neumaier) compensated summation
+type neumaierSum struct {
+	sum          float64
+	compensation float64
+}
+
+func (s *neumaierSum) add(n float64) {
+	t := s.sum + n
+	// Whichever operand is smaller in magnitude is the one that lost bits in the addition
+	if math.Abs(s.sum) >= math.Abs(n) {
+		s.compensation += (s.sum - t) + n
+	} else {
+		s.compensation += (n - t) + s.sum
+	}
+	s.sum = t
+}
+
+func (s neumaierSum) value() float64 {
+	return s.sum + s.compensation
+}
+
+// Product multiplies all of nums together, returning 1 when there are none. It stops as soon as it
+// sees a zero, so a zero anywhere gives 0 even if an infinity or NaN appears after it.
+func Product(nums ...float64) float64 {
+	product := 1.0
+	for _, n := range nums {
+		if n == 0 {
+			return 0
+		}
+		product = Multiply(product, n)
+	}
+
+	return product
+}
+
+// SquareRoot returns the square root of x or ErrNegativeInput if x is negative
+func SquareRoot(x float64) (float64, error) {
+	if x < 0 {
+		return 0, ErrNegativeInput
+	}
+
+	return math.Sqrt(x), nil
+}
+
+// DivMod divides x by y and returns the quotient truncated toward zero along with the remainder
+// x - quotient*y, so DivMod(17, 5) is (3, 2). Truncation means the remainder takes the sign of x:
+// DivMod(-17, 5) is (-3, -2) and DivMod(17, -5) is (-3, 2). The remainder is always exact, even
+// when the quotient is too large to be represented exactly. It returns ErrDivideByZero if y is
+// zero.
+func DivMod(x, y float64) (quotient float64, remainder float64, err error) {
+	if y == 0 {
+		return 0, 0, ErrDivideByZero
+	}
+
+	// Computing x - quotient*y would lose the remainder entirely once x/y passes 2^53
+	remainder = math.Mod(x, y)
+
+	return (x - remainder) / y, remainder, nil
+}
+
+// Abs returns the absolute value of x. Negative zero becomes positive zero and NaN stays NaN.
+func Abs(x float64) float64 {
+	return math.Abs(x)
+}
+
+// Sign returns -1 if x is negative, +1 if x is positive and 0 otherwise. Both zeros and NaN return
+// 0.
+func Sign(x float64) int {
+	switch {
+	case x < 0:
+		return -1
+	case x > 0:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// Negate returns -x, like the +/- key on a calculator. Zero of either sign is returned as positive
+// zero so that negating 0 never displays as -0. NaN stays NaN.
+func Negate(x float64) float64 {
+	if x == 0 {
+		return 0
+	}
+
+	return -x
+}
+
+// Reciprocal returns 1/x, like the 1/x key on a calculator. Zero of either sign returns
+// ErrDivideByZero.
+func Reciprocal(x float64) (float64, error) {
+	return Divide(1, x)
+}
+
 // Verify is an "example" of a wrapper for an html call. In this example, the API could be thought
 // of as not being made yet, but that doesn't prevent us from testing using mocks.
 func Verify(got, want float64) bool {
 	return true
 }
+
+// DivideRational divides x by y and returns the result as a reduced fraction such as "3/4". Whole
+// results are returned without a denominator and a negative result carries its sign on the
+// numerator. ErrOverflow is returned when moving the sign would need the negation of math.MinInt,
+// as in DivideRational(1, math.MinInt) or DivideRational(math.MinInt, -1).
+func DivideRational(x, y int) (string, error) {
+	if y == 0 {
+		return "", ErrDivideByZero
+	}
+
+	// Reducing before moving the sign means only results that really can't be written overflow
+	d := gcd(x, y)
+	x, y = x/d, y/d
+
+	if y < 0 {
+		if x == math.MinInt || y == math.MinInt {
+			return "", fmt.Errorf("%w: %d/%d", ErrOverflow, x, y)
+		}
+		x, y = -x, -y
+	}
+
+	if y == 1 {
+		return strconv.Itoa(x), nil
+	}
+
+	return strconv.Itoa(x) + "/" + strconv.Itoa(y), nil
+}
+
+// gcd returns the greatest common divisor of a and b, which is always positive as long as either
+// input is non-zero, except that it's math.MinInt when that's the divisor since its negation
+// overflows
+func gcd(a, b int) int {
+	if a < 0 {
+		a = -a
+	}
+	if b < 0 {
+		b = -b
+	}
+
+	for b != 0 {
+		a, b = b, a%b
+	}
+
+	return a
+}
@@ -3,6 +3,8 @@
 package calculator
 
 import (
+	"errors"
+	"math"
 	"os"
 	"strconv"
 	"testing"
@@ -176,3 +178,492 @@ func TestVerify(t *testing.T) {
 	// Verify like normal!
 	assert.Equal(t, expected, actual)
 }
+
+func TestDivideRational(t *testing.T) {
+	testCases := []struct {
+		name     string
+		x        int
+		y        int
+		expected string
+	}{
+		{name: "Reducible fraction", x: 6, y: 8, expected: "3/4"},
+		{name: "Already reduced", x: 2, y: 3, expected: "2/3"},
+		{name: "Whole number", x: 10, y: 5, expected: "2"},
+		{name: "Zero numerator", x: 0, y: 7, expected: "0"},
+		{name: "Negative numerator", x: -3, y: 6, expected: "-1/2"},
+		{name: "Negative denominator", x: 3, y: -6, expected: "-1/2"},
+		{name: "Both negative", x: -4, y: -6, expected: "2/3"},
+		{name: "Minimum numerator", x: math.MinInt, y: 2, expected: "-4611686018427387904"},
+		{name: "Minimum denominator", x: 2, y: math.MinInt, expected: "-1/4611686018427387904"},
+		{name: "Minimum over itself", x: math.MinInt, y: math.MinInt, expected: "1"},
+		{name: "Zero over minimum", x: 0, y: math.MinInt, expected: "0"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			actual, err := DivideRational(tc.x, tc.y)
+
+			assert.NoError(tt, err)
+			assert.Equal(tt, tc.expected, actual)
+		})
+	}
+}
+
+func TestDivideRationalByZero(t *testing.T) {
+	_, err := DivideRational(1, 0)
+
+	assert.Equal(t, ErrDivideByZero, err)
+}
+
+func TestDivideRationalOverflow(t *testing.T) {
+	testCases := []struct {
+		name string
+		x    int
+		y    int
+	}{
+		{name: "Minimum denominator", x: 1, y: math.MinInt},
+		{name: "Minimum numerator negated", x: math.MinInt, y: -1},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			_, err := DivideRational(tc.x, tc.y)
+
+			assert.True(tt, errors.Is(err, ErrOverflow), "expected ErrOverflow, got %v", err)
+		})
+	}
+}
+
+func TestDivide(t *testing.T) {
+	actual, err := Divide(9, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, 3.0, actual)
+
+	_, err = Divide(9, 0)
+	assert.Equal(t, ErrDivideByZero, err)
+}
+
+func TestAbs(t *testing.T) {
+	testCases := []struct {
+		name     string
+		x        float64
+		expected float64
+	}{
+		{name: "Positive", x: 3.2, expected: 3.2},
+		{name: "Negative", x: -3.2, expected: 3.2},
+		{name: "Negative infinity", x: math.Inf(-1), expected: math.Inf(1)},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			assert.Equal(tt, tc.expected, Abs(tc.x))
+		})
+	}
+
+	t.Run("Negative zero", func(tt *testing.T) {
+		actual := Abs(math.Copysign(0, -1))
+
+		assert.Equal(tt, 0.0, actual)
+		assert.False(tt, math.Signbit(actual))
+	})
+
+	t.Run("NaN", func(tt *testing.T) {
+		assert.True(tt, math.IsNaN(Abs(math.NaN())))
+	})
+}
+
+func TestSign(t *testing.T) {
+	testCases := []struct {
+		name     string
+		x        float64
+		expected int
+	}{
+		{name: "Positive", x: 0.001, expected: 1},
+		{name: "Negative", x: -3.2, expected: -1},
+		{name: "Zero", x: 0, expected: 0},
+		{name: "Negative zero", x: math.Copysign(0, -1), expected: 0},
+		{name: "NaN", x: math.NaN(), expected: 0},
+		{name: "Infinity", x: math.Inf(1), expected: 1},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			assert.Equal(tt, tc.expected, Sign(tc.x))
+		})
+	}
+}
+
+func TestNegate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		x        float64
+		expected float64
+	}{
+		{name: "Positive", x: 3.2, expected: -3.2},
+		{name: "Negative", x: -3.2, expected: 3.2},
+		{name: "Infinity", x: math.Inf(1), expected: math.Inf(-1)},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			assert.Equal(tt, tc.expected, Negate(tc.x))
+		})
+	}
+
+	t.Run("Zero", func(tt *testing.T) {
+		for _, zero := range []float64{0, math.Copysign(0, -1)} {
+			actual := Negate(zero)
+
+			assert.Equal(tt, 0.0, actual)
+			assert.False(tt, math.Signbit(actual))
+		}
+	})
+
+	t.Run("NaN", func(tt *testing.T) {
+		assert.True(tt, math.IsNaN(Negate(math.NaN())))
+	})
+}
+
+func TestReciprocal(t *testing.T) {
+	testCases := []struct {
+		name     string
+		x        float64
+		expected float64
+	}{
+		{name: "Positive", x: 4, expected: 0.25},
+		{name: "Negative", x: -0.5, expected: -2},
+		{name: "Infinity", x: math.Inf(1), expected: 0},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			actual, err := Reciprocal(tc.x)
+
+			assert.NoError(tt, err)
+			assert.Equal(tt, tc.expected, actual)
+		})
+	}
+
+	for _, zero := range []float64{0, math.Copysign(0, -1)} {
+		_, err := Reciprocal(zero)
+		assert.True(t, errors.Is(err, ErrDivideByZero), "expected ErrDivideByZero, got %v", err)
+	}
+}
+
+func TestSquareRoot(t *testing.T) {
+	actual, err := SquareRoot(16)
+	assert.NoError(t, err)
+	assert.Equal(t, 4.0, actual)
+
+	_, err = SquareRoot(-1)
+	assert.Equal(t, ErrNegativeInput, err)
+}
+
+func TestAddWithPrecisionWarning(t *testing.T) {
+	testCases := []struct {
+		name     string
+		x        float64
+		y        float64
+		expected float64
+		lost     bool
+	}{
+		{name: "Swallowed operand", x: 1e16, y: 1, expected: 1e16, lost: true},
+		{name: "Swallowed operand reversed", x: -1, y: -1e16, expected: -1e16, lost: true},
+		{name: "Tiny fraction", x: 1, y: 1e-17, expected: 1, lost: true},
+		{name: "Similar magnitudes", x: 1.5, y: 2.25, expected: 3.75, lost: false},
+		{name: "Far apart but representable", x: 1e10, y: 1, expected: 1e10 + 1, lost: false},
+		{name: "Zero", x: 1e300, y: 0, expected: 1e300, lost: false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			actual, lost := AddWithPrecisionWarning(tc.x, tc.y)
+
+			assert.Equal(tt, tc.expected, actual)
+			assert.Equal(tt, tc.lost, lost)
+		})
+	}
+}
+
+func TestReduce(t *testing.T) {
+	maxOf := func(acc, x float64) float64 {
+		if x > acc {
+			return x
+		}
+		return acc
+	}
+
+	testCases := []struct {
+		name     string
+		init     float64
+		op       func(acc, x float64) float64
+		nums     []float64
+		expected float64
+	}{
+		{name: "Add", init: 0, op: Add, nums: []float64{1, 2, 3, 4}, expected: 10},
+		{name: "Multiply", init: 1, op: Multiply, nums: []float64{1, 2, 3, 4}, expected: 24},
+		{name: "Subtract", init: 10, op: Subtract, nums: []float64{1, 2, 3}, expected: 4},
+		{name: "Max", init: math.Inf(-1), op: maxOf, nums: []float64{3, -1, 7, 2}, expected: 7},
+		{name: "Empty returns init", init: 42, op: Add, nums: nil, expected: 42},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			actual := Reduce(tc.init, tc.op, tc.nums...)
+
+			assert.Equal(tt, tc.expected, actual)
+		})
+	}
+}
+
+func TestSum(t *testing.T) {
+	assert.Equal(t, 0.0, Sum())
+	assert.Equal(t, 6.5, Sum(1, 2, 3.5))
+}
+
+func TestProduct(t *testing.T) {
+	testCases := []struct {
+		name     string
+		nums     []float64
+		expected float64
+	}{
+		{name: "Empty", nums: nil, expected: 1},
+		{name: "Single value", nums: []float64{7.5}, expected: 7.5},
+		{name: "Several values", nums: []float64{2, 3, 4}, expected: 24},
+		{name: "Includes zero", nums: []float64{5, 0, 3}, expected: 0},
+		{name: "Zero before infinity", nums: []float64{0, math.Inf(1)}, expected: 0},
+		{name: "Odd negatives", nums: []float64{-2, 3, -4, -1}, expected: -24},
+		{name: "Even negatives", nums: []float64{-2, -3}, expected: 6},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			assert.Equal(tt, tc.expected, Product(tc.nums...))
+		})
+	}
+}
+
+func TestSumFast(t *testing.T) {
+	testCases := []struct {
+		name string
+		nums []float64
+	}{
+		{name: "Empty", nums: nil},
+		{name: "Single", nums: []float64{4.5}},
+		{name: "Shorter than the unroll", nums: []float64{1, 2, 3}},
+		{name: "Multiple of the unroll", nums: []float64{1, 2, 3, 4, 5, 6, 7, 8}},
+		{name: "With a remainder", nums: []float64{-1, 2.5, 3, 4, 5, -6, 7, 8, 9.25}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			assert.Equal(tt, Sum(tc.nums...), SumFast(tc.nums))
+		})
+	}
+
+	t.Run("Fractions", func(tt *testing.T) {
+		nums := make([]float64, 1000)
+		for i := range nums {
+			nums[i] = 1 / float64(i+1)
+		}
+
+		assert.InEpsilon(tt, Sum(nums...), SumFast(nums), 1e-13)
+	})
+}
+
+// benchResult keeps the compiler from optimizing the benchmarked calls away
+var benchResult float64
+
+// testBenchNums builds the 10 million element slice used by the summation benchmarks
+func testBenchNums() []float64 {
+	nums := make([]float64, 10000000)
+	for i := range nums {
+		nums[i] = float64(i%1000) * 0.001
+	}
+
+	return nums
+}
+
+// Benchmarks measure how long code takes to run and are triggered with `go test -bench .`
+// More: https://golang.org/pkg/testing/#hdr-Benchmarks
+// b.ResetTimer() keeps the setup out of the measurement.
+func BenchmarkSum(b *testing.B) {
+	nums := testBenchNums()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		benchResult = Sum(nums...)
+	}
+}
+
+func BenchmarkSumFast(b *testing.B) {
+	nums := testBenchNums()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		benchResult = SumFast(nums)
+	}
+}
+
+func TestKahanSum(t *testing.T) {
+	testCases := []struct {
+		name     string
+		nums     []float64
+		expected float64
+	}{
+		{name: "Empty", nums: nil, expected: 0},
+		{name: "Ordinary values", nums: []float64{1, 2, 3.5}, expected: 6.5},
+		{name: "Cancelling large values", nums: []float64{1e16, 1, -1e16}, expected: 1},
+		{name: "Small value first", nums: []float64{1, 1e100, 1, -1e100}, expected: 2},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			assert.Equal(tt, tc.expected, KahanSum(tc.nums...))
+		})
+	}
+}
+
+// Plain summation rounds the 1 away as soon as it's added to 1e16, so nothing's left once the
+// large values cancel
+func TestKahanSumAdversarial(t *testing.T) {
+	nums := []float64{1e16, 1, -1e16}
+
+	assert.Equal(t, 0.0, Sum(nums...))
+	assert.Equal(t, 1.0, KahanSum(nums...))
+}
+
+func TestKahanSumManySmallValues(t *testing.T) {
+	nums := []float64{1e9}
+	for i := 0; i < 100000; i++ {
+		nums = append(nums, 0.1)
+	}
+
+	assert.Equal(t, 1e9+10000, KahanSum(nums...))
+	assert.NotEqual(t, 1e9+10000, Sum(nums...))
+}
+
+func TestCumSum(t *testing.T) {
+	testCases := []struct {
+		name     string
+		nums     []float64
+		expected []float64
+	}{
+		{name: "Empty", nums: []float64{}, expected: []float64{}},
+		{name: "Nil", nums: nil, expected: []float64{}},
+		{name: "Single element", nums: []float64{4.5}, expected: []float64{4.5}},
+		{name: "Series with negatives", nums: []float64{3, -1, 4, -6, 2.5}, expected: []float64{3, 2, 6, 0, 2.5}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			assert.Equal(tt, tc.expected, CumSum(tc.nums))
+			assert.Equal(tt, tc.expected, CumSumKahan(tc.nums))
+		})
+	}
+}
+
+func TestCumSumDoesNotModifyInput(t *testing.T) {
+	nums := []float64{1, 2, 3}
+
+	CumSum(nums)
+	CumSumKahan(nums)
+
+	assert.Equal(t, []float64{1, 2, 3}, nums)
+}
+
+func TestCumSumKahanAccuracy(t *testing.T) {
+	nums := []float64{1e16, 1, -1e16, 1}
+
+	assert.Equal(t, []float64{1e16, 1e16, 0, 1}, CumSum(nums))
+	assert.Equal(t, []float64{1e16, 1e16, 1, 2}, CumSumKahan(nums))
+}
+
+func TestAddInt(t *testing.T) {
+	testCases := []struct {
+		name     string
+		x        int64
+		y        int64
+		expected int64
+	}{
+		{name: "Positive", x: 2, y: 3, expected: 5},
+		{name: "Mixed signs", x: -7, y: 3, expected: -4},
+		{name: "Max plus zero", x: math.MaxInt64, y: 0, expected: math.MaxInt64},
+		{name: "Max plus min", x: math.MaxInt64, y: math.MinInt64, expected: -1},
+		{name: "Reaches max", x: math.MaxInt64 - 1, y: 1, expected: math.MaxInt64},
+		{name: "Reaches min", x: math.MinInt64 + 1, y: -1, expected: math.MinInt64},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			actual, err := AddInt(tc.x, tc.y)
+
+			assert.NoError(tt, err)
+			assert.Equal(tt, tc.expected, actual)
+		})
+	}
+}
+
+func TestAddIntOverflow(t *testing.T) {
+	testCases := []struct {
+		name string
+		x    int64
+		y    int64
+	}{
+		{name: "Max plus one", x: math.MaxInt64, y: 1},
+		{name: "Min minus one", x: math.MinInt64, y: -1},
+		{name: "Max plus max", x: math.MaxInt64, y: math.MaxInt64},
+		{name: "Min plus min", x: math.MinInt64, y: math.MinInt64},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			_, err := AddInt(tc.x, tc.y)
+
+			assert.True(tt, errors.Is(err, ErrOverflow), "expected ErrOverflow, got %v", err)
+		})
+	}
+}
+
+func TestDivMod(t *testing.T) {
+	testCases := []struct {
+		name      string
+		x         float64
+		y         float64
+		quotient  float64
+		remainder float64
+	}{
+		{name: "Positive", x: 17, y: 5, quotient: 3, remainder: 2},
+		{name: "Exact", x: 15, y: 5, quotient: 3, remainder: 0},
+		{name: "Negative dividend", x: -17, y: 5, quotient: -3, remainder: -2},
+		{name: "Negative divisor", x: 17, y: -5, quotient: -3, remainder: 2},
+		{name: "Both negative", x: -17, y: -5, quotient: 3, remainder: -2},
+		{name: "Dividend smaller than divisor", x: 3, y: 5, quotient: 0, remainder: 3},
+		{name: "Fractions", x: 7.5, y: 2, quotient: 3, remainder: 1.5},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			quotient, remainder, err := DivMod(tc.x, tc.y)
+
+			assert.NoError(tt, err)
+			assert.Equal(tt, tc.quotient, quotient)
+			assert.Equal(tt, tc.remainder, remainder)
+		})
+	}
+}
+
+// Past 2^53 the quotient can't be represented exactly, but the remainder still can
+func TestDivModLargeMagnitude(t *testing.T) {
+	testCases := []struct {
+		name      string
+		x         float64
+		y         float64
+		quotient  float64
+		remainder float64
+	}{
+		{name: "1e17 by 3", x: 1e17, y: 3, quotient: 1e17 / 3, remainder: 1},
+		{name: "1e20 by 7", x: 1e20, y: 7, quotient: 1e20 / 7, remainder: 2},
+		{name: "Negative", x: -1e20, y: 7, quotient: -1e20 / 7, remainder: -2},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			quotient, remainder, err := DivMod(tc.x, tc.y)
+
+			assert.NoError(tt, err)
+			assert.InEpsilon(tt, tc.quotient, quotient, 1e-15)
+			assert.Equal(tt, tc.remainder, remainder)
+		})
+	}
+}
+
+func TestDivModByZero(t *testing.T) {
+	_, _, err := DivMod(17, 0)
+
+	assert.Equal(t, ErrDivideByZero, err)
+}
@@ -0,0 +1,58 @@
+package calculator
+
+// PrimeFactors returns the prime factorization of n as a map of each prime factor to its
+// exponent, so 12 (2*2*3) becomes {2: 2, 3: 1}. The factorization of 1 is empty.
+func PrimeFactors(n int64) (map[int64]int, error) {
+	if n <= 0 {
+		return nil, ErrNonPositiveInput
+	}
+
+	factors := map[int64]int{}
+	for p := int64(2); p <= n/p; p++ {
+		for n%p == 0 {
+			factors[p]++
+			n /= p
+		}
+	}
+
+	// Whatever is left after removing every factor up to its square root must itself be prime
+	if n > 1 {
+		factors[n]++
+	}
+
+	return factors, nil
+}
+
+// Totient returns Euler's totient of n, the count of integers from 1 to n that share no factor
+// with n. It's computed from the prime factorization as n multiplied by (1 - 1/p) for each
+// distinct prime p dividing n.
+func Totient(n int64) (int64, error) {
+	factors, err := PrimeFactors(n)
+	if err != nil {
+		return 0, err
+	}
+
+	result := n
+	for p := range factors {
+		result = result / p * (p - 1)
+	}
+
+	return result, nil
+}
+
+// HarmonicNumber returns the nth harmonic number, 1 + 1/2 + ... + 1/n. Terms are added smallest
+// first so that the tiny trailing terms of a large n accumulate before meeting the larger leading
+// ones rather than being rounded away one at a time. Even so, float64 precision means results for
+// very large n are only accurate to roughly 1e-12 relative error.
+func HarmonicNumber(n int) (float64, error) {
+	if n < 1 {
+		return 0, ErrNonPositiveInput
+	}
+
+	var sum float64
+	for k := n; k >= 1; k-- {
+		sum += 1 / float64(k)
+	}
+
+	return sum, nil
+}
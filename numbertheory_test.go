@@ -0,0 +1,95 @@
+package calculator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrimeFactors(t *testing.T) {
+	testCases := []struct {
+		name     string
+		n        int64
+		expected map[int64]int
+	}{
+		{name: "One", n: 1, expected: map[int64]int{}},
+		{name: "Prime", n: 13, expected: map[int64]int{13: 1}},
+		{name: "Prime power", n: 81, expected: map[int64]int{3: 4}},
+		{name: "Composite", n: 12, expected: map[int64]int{2: 2, 3: 1}},
+		{name: "Multiple factors", n: 360360, expected: map[int64]int{2: 3, 3: 2, 5: 1, 7: 1, 11: 1, 13: 1}},
+		{name: "Large prime factor", n: 2 * 1000000007, expected: map[int64]int{2: 1, 1000000007: 1}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			actual, err := PrimeFactors(tc.n)
+
+			assert.NoError(tt, err)
+			assert.Equal(tt, tc.expected, actual)
+		})
+	}
+}
+
+func TestPrimeFactorsNonPositive(t *testing.T) {
+	for _, n := range []int64{0, -12} {
+		_, err := PrimeFactors(n)
+
+		assert.Equal(t, ErrNonPositiveInput, err)
+	}
+}
+
+func TestTotient(t *testing.T) {
+	testCases := []struct {
+		name     string
+		n        int64
+		expected int64
+	}{
+		{name: "One", n: 1, expected: 1},
+		{name: "Prime", n: 13, expected: 12},
+		{name: "Prime power", n: 27, expected: 18},
+		{name: "Composite", n: 36, expected: 12},
+		{name: "Product of distinct primes", n: 105, expected: 48},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			actual, err := Totient(tc.n)
+
+			assert.NoError(tt, err)
+			assert.Equal(tt, tc.expected, actual)
+		})
+	}
+}
+
+func TestTotientNonPositive(t *testing.T) {
+	_, err := Totient(0)
+
+	assert.Equal(t, ErrNonPositiveInput, err)
+}
+
+func TestHarmonicNumber(t *testing.T) {
+	testCases := []struct {
+		name     string
+		n        int
+		expected float64
+	}{
+		{name: "First", n: 1, expected: 1},
+		{name: "Second", n: 2, expected: 1.5},
+		{name: "Third", n: 3, expected: 11.0 / 6},
+		{name: "Fifth", n: 5, expected: 137.0 / 60},
+		// For large n, H(n) is approximately ln(n) + γ + 1/2n - 1/12n²
+		{name: "Large", n: 1000000, expected: 14.392726722865724},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			actual, err := HarmonicNumber(tc.n)
+
+			assert.NoError(tt, err)
+			assert.InDelta(tt, tc.expected, actual, 1e-12)
+		})
+	}
+}
+
+func TestHarmonicNumberNonPositive(t *testing.T) {
+	_, err := HarmonicNumber(0)
+
+	assert.Equal(t, ErrNonPositiveInput, err)
+}
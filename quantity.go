@@ -0,0 +1,20 @@
+package calculator
+
+import "fmt"
+
+// Quantity is a value paired with the unit it's measured in. An empty Unit means the value is
+// dimensionless.
+type Quantity struct {
+	Value float64
+	Unit  string
+}
+
+// AddQuantities sums two quantities of the same unit. Units are compared exactly, so a
+// dimensionless quantity can only be added to another dimensionless quantity.
+func AddQuantities(a, b Quantity) (Quantity, error) {
+	if a.Unit != b.Unit {
+		return Quantity{}, fmt.Errorf("%w: %q and %q", ErrUnitMismatch, a.Unit, b.Unit)
+	}
+
+	return Quantity{Value: Add(a.Value, b.Value), Unit: a.Unit}, nil
+}
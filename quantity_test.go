@@ -0,0 +1,46 @@
+package calculator
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddQuantities(t *testing.T) {
+	testCases := []struct {
+		name     string
+		a        Quantity
+		b        Quantity
+		expected Quantity
+	}{
+		{name: "Matching units", a: Quantity{Value: 2, Unit: "m"}, b: Quantity{Value: 3.5, Unit: "m"}, expected: Quantity{Value: 5.5, Unit: "m"}},
+		{name: "Dimensionless", a: Quantity{Value: 2}, b: Quantity{Value: -3}, expected: Quantity{Value: -1}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			actual, err := AddQuantities(tc.a, tc.b)
+
+			assert.NoError(tt, err)
+			assert.Equal(tt, tc.expected, actual)
+		})
+	}
+}
+
+func TestAddQuantitiesUnitMismatch(t *testing.T) {
+	testCases := []struct {
+		name string
+		a    Quantity
+		b    Quantity
+	}{
+		{name: "Different units", a: Quantity{Value: 1, Unit: "m"}, b: Quantity{Value: 1, Unit: "s"}},
+		{name: "Unit and dimensionless", a: Quantity{Value: 1, Unit: "m"}, b: Quantity{Value: 1}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			_, err := AddQuantities(tc.a, tc.b)
+
+			assert.True(tt, errors.Is(err, ErrUnitMismatch))
+		})
+	}
+}
@@ -0,0 +1,104 @@
+package calculator
+
+import (
+	"bufio"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// SumReader sums the whitespace separated numbers read from r, returning the total along with how
+// many numbers were read. Input is consumed one token at a time so large streams never need to fit
+// in memory. Reading stops at the first token that isn't a number.
+func SumReader(r io.Reader) (float64, int, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Split(bufio.ScanWords)
+
+	var sum float64
+	var count int
+	for scanner.Scan() {
+		tok := scanner.Text()
+
+		n, err := strconv.ParseFloat(tok, 64)
+		if err != nil {
+			// The token is already in the message, so only keep the reason from the NumError
+			var numErr *strconv.NumError
+			if errors.As(err, &numErr) {
+				err = numErr.Err
+			}
+			return sum, count, fmt.Errorf("calculator: token %d %q: %w", count+1, tok, err)
+		}
+
+		sum += n
+		count++
+	}
+
+	if err := scanner.Err(); err != nil {
+		return sum, count, err
+	}
+
+	return sum, count, nil
+}
+
+// SumCSVColumn reads CSV rows from r and sums the numbers in the given zero-based column,
+// returning the total and how many rows were summed. Set skipHeader to ignore the first row. Cells
+// are parsed with ParseNumber, so surrounding spaces and comma grouping such as "1,234.5" are
+// allowed. Errors name the offending row, counting from 1 and including any header, and like
+// SumReader the total and count of the rows summed before it are returned alongside the error.
+func SumCSVColumn(r io.Reader, columnIndex int, skipHeader bool) (float64, int, error) {
+	if columnIndex < 0 {
+		return 0, 0, fmt.Errorf("%w: column %d", ErrOutOfRange, columnIndex)
+	}
+
+	reader := csv.NewReader(r)
+	// Rows are allowed to vary in length as long as each one has the column being summed
+	reader.FieldsPerRecord = -1
+
+	var sum float64
+	var count int
+	for row := 1; ; row++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return sum, count, &rowError{row: row, err: err}
+		}
+
+		if row == 1 && skipHeader {
+			continue
+		}
+
+		if columnIndex >= len(record) {
+			return sum, count, fmt.Errorf("%w: row %d has %d columns, no column %d", ErrOutOfRange, row, len(record), columnIndex)
+		}
+
+		n, err := ParseNumber(record[columnIndex])
+		if err != nil {
+			return sum, count, &rowError{row: row, err: err}
+		}
+
+		sum += n
+		count++
+	}
+
+	return sum, count, nil
+}
+
+// rowError adds the row number to an error from reading or parsing a CSV row, keeping the package
+// prefix at the front of the message exactly once
+type rowError struct {
+	row int
+	err error
+}
+
+func (e *rowError) Error() string {
+	return fmt.Sprintf("calculator: row %d: %s", e.row, strings.TrimPrefix(e.err.Error(), "calculator: "))
+}
+
+func (e *rowError) Unwrap() error {
+	return e.err
+}
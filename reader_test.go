@@ -0,0 +1,114 @@
+package calculator
+
+import (
+	"encoding/csv"
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSumReader(t *testing.T) {
+	testCases := []struct {
+		name          string
+		input         string
+		expectedSum   float64
+		expectedCount int
+	}{
+		{name: "Single line", input: "1 2 3.5", expectedSum: 6.5, expectedCount: 3},
+		{name: "Mixed whitespace", input: "  10\n-4\t\t2e1\n\n", expectedSum: 26, expectedCount: 3},
+		{name: "Empty", input: "", expectedSum: 0, expectedCount: 0},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			sum, count, err := SumReader(strings.NewReader(tc.input))
+
+			assert.NoError(tt, err)
+			assert.Equal(tt, tc.expectedSum, sum)
+			assert.Equal(tt, tc.expectedCount, count)
+		})
+	}
+}
+
+func TestSumReaderMalformedToken(t *testing.T) {
+	sum, count, err := SumReader(strings.NewReader("1 2 three 4"))
+
+	assert.Equal(t, 3.0, sum)
+	assert.Equal(t, 2, count)
+	assert.True(t, errors.Is(err, strconv.ErrSyntax))
+	assert.EqualError(t, err, `calculator: token 3 "three": invalid syntax`)
+}
+
+func TestSumCSVColumn(t *testing.T) {
+	testCases := []struct {
+		name          string
+		input         string
+		column        int
+		skipHeader    bool
+		expectedSum   float64
+		expectedCount int
+	}{
+		{name: "With header", input: "item,price\napple,1.25\npear,2.5\n", column: 1, skipHeader: true, expectedSum: 3.75, expectedCount: 2},
+		{name: "Without header", input: "1,10\n2,20\n3,30", column: 0, expectedSum: 6, expectedCount: 3},
+		{name: "Quoted grouped numbers", input: "\"1,000\",x\n\" 234.5 \",y\n", column: 0, expectedSum: 1234.5, expectedCount: 2},
+		{name: "Ragged rows", input: "a,1\nb,2,extra\n", column: 1, expectedSum: 3, expectedCount: 2},
+		{name: "Empty", input: "", column: 3, expectedSum: 0, expectedCount: 0},
+		{name: "Header only", input: "item,price\n", column: 1, skipHeader: true, expectedSum: 0, expectedCount: 0},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			sum, count, err := SumCSVColumn(strings.NewReader(tc.input), tc.column, tc.skipHeader)
+
+			assert.NoError(tt, err)
+			assert.Equal(tt, tc.expectedSum, sum)
+			assert.Equal(tt, tc.expectedCount, count)
+		})
+	}
+}
+
+func TestSumCSVColumnMalformedCell(t *testing.T) {
+	input := "item,price\napple,1.25\npear,two\n"
+
+	sum, count, err := SumCSVColumn(strings.NewReader(input), 1, true)
+
+	assert.Equal(t, 1.25, sum)
+	assert.Equal(t, 1, count)
+	assert.True(t, errors.Is(err, strconv.ErrSyntax))
+	assert.EqualError(t, err, `calculator: row 3: invalid number "two": invalid syntax`)
+}
+
+func TestSumCSVColumnMalformedCSV(t *testing.T) {
+	input := "1,2\n3,4\n5,\"6\n"
+
+	sum, count, err := SumCSVColumn(strings.NewReader(input), 0, false)
+
+	assert.Equal(t, 4.0, sum)
+	assert.Equal(t, 2, count)
+
+	var parseErr *csv.ParseError
+	assert.True(t, errors.As(err, &parseErr), "expected a csv.ParseError, got %v", err)
+	assert.True(t, strings.HasPrefix(err.Error(), "calculator: row 3: "), err.Error())
+}
+
+func TestSumCSVColumnOutOfRange(t *testing.T) {
+	testCases := []struct {
+		name   string
+		input  string
+		column int
+		msg    string
+	}{
+		{name: "Past the last column", input: "1,2\n", column: 2, msg: "calculator: value out of range: row 1 has 2 columns, no column 2"},
+		{name: "Short row", input: "1,2\n3\n", column: 1, msg: "calculator: value out of range: row 2 has 1 columns, no column 1"},
+		{name: "Negative", input: "1,2\n", column: -1, msg: "calculator: value out of range: column -1"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			_, _, err := SumCSVColumn(strings.NewReader(tc.input), tc.column, false)
+
+			assert.True(tt, errors.Is(err, ErrOutOfRange))
+			assert.EqualError(tt, err, tc.msg)
+		})
+	}
+}
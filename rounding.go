@@ -0,0 +1,131 @@
+package calculator
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// RoundingMode chooses how RoundMode resolves values that fall between two candidates
+type RoundingMode int
+
+const (
+	// RoundHalfUp rounds to the nearest value with ties going away from zero, so 2.5 becomes 3
+	// and -2.5 becomes -3
+	RoundHalfUp RoundingMode = iota
+	// RoundHalfEven rounds to the nearest value with ties going to the even neighbor, also known as
+	// banker's rounding, so 2.5 becomes 2 and 3.5 becomes 4
+	RoundHalfEven
+	// RoundFloor always rounds toward negative infinity
+	RoundFloor
+	// RoundCeil always rounds toward positive infinity
+	RoundCeil
+)
+
+// Round rounds x to the given number of decimal places using RoundHalfUp
+func Round(x float64, places int) float64 {
+	return RoundMode(x, places, RoundHalfUp)
+}
+
+// RoundMode rounds x to the given number of decimal places using mode. A negative number of
+// places rounds to the left of the decimal point, so -2 rounds to the nearest hundred. Keep in
+// mind that most decimal fractions aren't exactly representable as a float64, so a value such as
+// 2.675 is really slightly below its written form and rounds down. NaN and infinities are
+// returned unchanged.
+func RoundMode(x float64, places int, mode RoundingMode) float64 {
+	if math.IsNaN(x) || math.IsInf(x, 0) {
+		return x
+	}
+
+	round := roundFunc(mode)
+
+	if places < 0 {
+		scale := math.Pow(10, float64(-places))
+		if math.IsInf(scale, 0) {
+			// Every finite x is negligible next to a power of ten that large
+			return math.Copysign(0, x)
+		}
+		return round(x/scale) * scale
+	}
+
+	scale := math.Pow(10, float64(places))
+	scaled := x * scale
+	if math.IsInf(scaled, 0) || math.IsNaN(scaled) {
+		// x is too large to have any digits at this many places, or places is so large that scale
+		// overflowed, so there's nothing to round
+		return x
+	}
+
+	return round(scaled) / scale
+}
+
+// Floor returns the greatest whole number less than or equal to x. Zero keeps its sign, as do
+// values between -1 and 0, which give -0. NaN and infinities are returned unchanged.
+func Floor(x float64) float64 {
+	return math.Floor(x)
+}
+
+// Ceil returns the least whole number greater than or equal to x. Zero keeps its sign, as do values
+// between -1 and 0, which give -0. NaN and infinities are returned unchanged.
+func Ceil(x float64) float64 {
+	return math.Ceil(x)
+}
+
+// Trunc returns the whole number part of x, dropping any fraction so it rounds toward zero. The
+// result keeps the sign of x, so -0.5 gives -0. NaN and infinities are returned unchanged.
+func Trunc(x float64) float64 {
+	return math.Trunc(x)
+}
+
+// RoundSigFigs rounds x to the given number of significant figures using RoundHalfUp, so 123456 to
+// 3 figures is 123000 and 0.0012345 to 2 figures is 0.0012. The rounding is done on the digits of
+// x's shortest decimal form, as in NewDecimalCalculator, so it's equally accurate from subnormals
+// up to the largest float64 and the result is the float64 nearest the rounded decimal. Rounding
+// above the largest float64 gives an infinity. Zero, NaN and infinities are returned unchanged,
+// as is x when figs is less than 1 since there's no meaningful result to give.
+func RoundSigFigs(x float64, figs int) float64 {
+	if figs < 1 || x == 0 || math.IsNaN(x) || math.IsInf(x, 0) {
+		return x
+	}
+
+	// Scientific notation puts every significant digit in the mantissa, such as "1.2355e-03"
+	mantissa, exp := splitScientific(strconv.FormatFloat(math.Abs(x), 'e', -1, 64))
+	digits := strings.Replace(mantissa, ".", "", 1)
+	if len(digits) <= figs {
+		return x
+	}
+
+	// A float64 has at most 17 significant digits, so the kept ones always fit in a uint64
+	kept, _ := strconv.ParseUint(digits[:figs], 10, 64)
+	if digits[figs] >= '5' {
+		kept++
+	}
+
+	// An out of range result is returned as an infinity along with the error, which is wanted here
+	rounded, _ := strconv.ParseFloat(fmt.Sprintf("%de%d", kept, exp-(figs-1)), 64)
+
+	return math.Copysign(rounded, x)
+}
+
+// splitScientific splits a number formatted with the 'e' verb into its mantissa and exponent
+func splitScientific(s string) (mantissa string, exp int) {
+	i := strings.IndexByte(s, 'e')
+	exp, _ = strconv.Atoi(s[i+1:])
+
+	return s[:i], exp
+}
+
+// roundFunc returns the function that rounds to a whole number for mode
+func roundFunc(mode RoundingMode) func(float64) float64 {
+	switch mode {
+	case RoundHalfEven:
+		return math.RoundToEven
+	case RoundFloor:
+		return math.Floor
+	case RoundCeil:
+		return math.Ceil
+	default:
+		return math.Round
+	}
+}
@@ -0,0 +1,161 @@
+package calculator
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRoundMode(t *testing.T) {
+	testCases := []struct {
+		name     string
+		x        float64
+		places   int
+		mode     RoundingMode
+		expected float64
+	}{
+		{name: "Half up 2.5", x: 2.5, mode: RoundHalfUp, expected: 3},
+		{name: "Half up 3.5", x: 3.5, mode: RoundHalfUp, expected: 4},
+		{name: "Half up -2.5", x: -2.5, mode: RoundHalfUp, expected: -3},
+		{name: "Half even 2.5", x: 2.5, mode: RoundHalfEven, expected: 2},
+		{name: "Half even 3.5", x: 3.5, mode: RoundHalfEven, expected: 4},
+		{name: "Half even -2.5", x: -2.5, mode: RoundHalfEven, expected: -2},
+		{name: "Half even -3.5", x: -3.5, mode: RoundHalfEven, expected: -4},
+		{name: "Floor 2.5", x: 2.5, mode: RoundFloor, expected: 2},
+		{name: "Floor -2.5", x: -2.5, mode: RoundFloor, expected: -3},
+		{name: "Ceil 2.5", x: 2.5, mode: RoundCeil, expected: 3},
+		{name: "Ceil -2.5", x: -2.5, mode: RoundCeil, expected: -2},
+		{name: "Half up with places", x: 1.25, places: 1, mode: RoundHalfUp, expected: 1.3},
+		{name: "Half even with places", x: 1.25, places: 1, mode: RoundHalfEven, expected: 1.2},
+		{name: "Floor with places", x: -1.21, places: 1, mode: RoundFloor, expected: -1.3},
+		{name: "Ceil with places", x: 1.21, places: 1, mode: RoundCeil, expected: 1.3},
+		{name: "Negative places", x: 1250, places: -2, mode: RoundHalfEven, expected: 1200},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			actual := RoundMode(tc.x, tc.places, tc.mode)
+
+			assert.Equal(tt, tc.expected, actual)
+		})
+	}
+}
+
+// math.Pow overflows to Inf for places this extreme, which must not turn into 0*Inf = NaN
+func TestRoundModeExtremePlaces(t *testing.T) {
+	testCases := []struct {
+		name     string
+		x        float64
+		places   int
+		expected float64
+	}{
+		{name: "Zero with many places", x: 0, places: 400, expected: 0},
+		{name: "Fraction with many places", x: 1.25, places: 400, expected: 1.25},
+		{name: "Many places to the left", x: 123, places: -400, expected: 0},
+		{name: "Negative with many places to the left", x: -123, places: -400, expected: 0},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			actual := RoundMode(tc.x, tc.places, RoundHalfUp)
+
+			assert.Equal(tt, tc.expected, actual)
+			assert.Equal(tt, math.Signbit(tc.x), math.Signbit(actual))
+		})
+	}
+
+	assert.True(t, math.IsNaN(RoundMode(math.NaN(), 400, RoundHalfUp)))
+	assert.True(t, math.IsNaN(RoundMode(math.NaN(), -400, RoundHalfUp)))
+	assert.Equal(t, math.Inf(-1), RoundMode(math.Inf(-1), -400, RoundHalfUp))
+}
+
+func TestRound(t *testing.T) {
+	assert.Equal(t, 3.0, Round(2.5, 0))
+	assert.Equal(t, -3.14, Round(-3.14159, 2))
+	assert.Equal(t, 1e308, Round(1e308, 10))
+}
+
+func TestRoundSigFigs(t *testing.T) {
+	testCases := []struct {
+		name     string
+		x        float64
+		figs     int
+		expected float64
+	}{
+		{name: "Large", x: 123456, figs: 3, expected: 123000},
+		{name: "Small fraction", x: 0.0012345, figs: 2, expected: 0.0012},
+		{name: "Rounds up", x: 0.0012355, figs: 3, expected: 0.00124},
+		{name: "Carries into a new digit", x: 999.96, figs: 3, expected: 1000},
+		{name: "Power of ten", x: 1000, figs: 1, expected: 1000},
+		{name: "Negative", x: -98765, figs: 2, expected: -99000},
+		{name: "Negative fraction", x: -0.000456789, figs: 3, expected: -0.000457},
+		{name: "More figures than digits", x: 1.5, figs: 10, expected: 1.5},
+		{name: "Very large", x: 6.02214076e23, figs: 3, expected: 6.02e23},
+		{name: "Very small", x: 1.602176634e-19, figs: 4, expected: 1.602e-19},
+		{name: "Zero", x: 0, figs: 3, expected: 0},
+		{name: "Zero figures", x: 123.456, figs: 0, expected: 123.456},
+		{name: "Negative figures", x: 123.456, figs: -1, expected: 123.456},
+		{name: "Infinity", x: math.Inf(1), figs: 3, expected: math.Inf(1)},
+		{name: "Tie rounds away from zero", x: 2.5, figs: 1, expected: 3},
+		{name: "Decimal tie", x: 2.675, figs: 3, expected: 2.68},
+		{name: "Near the smallest normal", x: 1.23456e-306, figs: 3, expected: 1.23e-306},
+		{name: "Below the smallest normal", x: 1.23456e-307, figs: 3, expected: 1.23e-307},
+		{name: "Subnormal", x: 1.23456e-320, figs: 2, expected: 1.2e-320},
+		{name: "Smallest subnormal", x: 5e-324, figs: 1, expected: 5e-324},
+		{name: "Near the largest float64", x: 1.2345e308, figs: 2, expected: 1.2e308},
+		{name: "Beyond the largest float64", x: math.MaxFloat64, figs: 1, expected: math.Inf(1)},
+		{name: "Negative beyond the largest float64", x: -math.MaxFloat64, figs: 1, expected: math.Inf(-1)},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			assert.Equal(tt, tc.expected, RoundSigFigs(tc.x, tc.figs))
+		})
+	}
+
+	assert.True(t, math.IsNaN(RoundSigFigs(math.NaN(), 3)))
+}
+
+func TestFloorCeilTrunc(t *testing.T) {
+	testCases := []struct {
+		name  string
+		x     float64
+		floor float64
+		ceil  float64
+		trunc float64
+	}{
+		{name: "Positive fraction", x: 2.7, floor: 2, ceil: 3, trunc: 2},
+		{name: "Negative fraction", x: -2.7, floor: -3, ceil: -2, trunc: -2},
+		{name: "Half", x: 0.5, floor: 0, ceil: 1, trunc: 0},
+		{name: "Integer", x: 4, floor: 4, ceil: 4, trunc: 4},
+		{name: "Negative integer", x: -4, floor: -4, ceil: -4, trunc: -4},
+		{name: "Large", x: 1e300, floor: 1e300, ceil: 1e300, trunc: 1e300},
+		{name: "Infinity", x: math.Inf(1), floor: math.Inf(1), ceil: math.Inf(1), trunc: math.Inf(1)},
+		{name: "Negative infinity", x: math.Inf(-1), floor: math.Inf(-1), ceil: math.Inf(-1), trunc: math.Inf(-1)},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			assert.Equal(tt, tc.floor, Floor(tc.x))
+			assert.Equal(tt, tc.ceil, Ceil(tc.x))
+			assert.Equal(tt, tc.trunc, Trunc(tc.x))
+		})
+	}
+
+	t.Run("Sign of zero", func(tt *testing.T) {
+		negZero := math.Copysign(0, -1)
+
+		assert.True(tt, math.Signbit(Floor(negZero)))
+		assert.True(tt, math.Signbit(Ceil(negZero)))
+		assert.True(tt, math.Signbit(Trunc(negZero)))
+		assert.False(tt, math.Signbit(Floor(0)))
+		assert.False(tt, math.Signbit(Ceil(0)))
+		assert.False(tt, math.Signbit(Trunc(0)))
+
+		assert.True(tt, math.Signbit(Ceil(-0.5)))
+		assert.True(tt, math.Signbit(Trunc(-0.5)))
+	})
+
+	t.Run("NaN", func(tt *testing.T) {
+		assert.True(tt, math.IsNaN(Floor(math.NaN())))
+		assert.True(tt, math.IsNaN(Ceil(math.NaN())))
+		assert.True(tt, math.IsNaN(Trunc(math.NaN())))
+	})
+}
@@ -0,0 +1,71 @@
+package calculator
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// rpnOperators are the binary operators understood by EvalRPN
+var rpnOperators = map[string]func(x, y float64) (float64, error){
+	"+": func(x, y float64) (float64, error) { return Add(x, y), nil },
+	"-": func(x, y float64) (float64, error) { return Subtract(x, y), nil },
+	"*": func(x, y float64) (float64, error) { return Multiply(x, y), nil },
+	"/": Divide,
+	"^": func(x, y float64) (float64, error) { return math.Pow(x, y), nil },
+}
+
+// EvalRPN evaluates an expression written in reverse Polish (postfix) notation, where each
+// operator follows its two operands. For example {"3", "4", "+", "2", "*"} is (3 + 4) * 2. The
+// supported operators are the same as Eval's, and operands are numbers written as Eval accepts
+// them with an optional leading sign, so spellings strconv allows such as "NaN", "Inf" and "0x1p3"
+// are rejected. Malformed input returns an error wrapping ErrMalformedExpression that names the
+// offending token by its 1-based position.
+func EvalRPN(tokens []string) (float64, error) {
+	var stack []float64
+
+	for i, tok := range tokens {
+		if op, ok := rpnOperators[tok]; ok {
+			if len(stack) < 2 {
+				return 0, fmt.Errorf("%w: operator %q at token %d needs 2 operands, found %d", ErrMalformedExpression, tok, i+1, len(stack))
+			}
+
+			x, y := stack[len(stack)-2], stack[len(stack)-1]
+			stack = stack[:len(stack)-2]
+
+			result, err := op(x, y)
+			if err != nil {
+				return 0, fmt.Errorf("%w at token %d", err, i+1)
+			}
+
+			stack = append(stack, result)
+			continue
+		}
+
+		n, err := strconv.ParseFloat(tok, 64)
+		if err != nil || !isRPNNumber(tok) {
+			return 0, fmt.Errorf("%w: unknown token %q at token %d", ErrMalformedExpression, tok, i+1)
+		}
+
+		stack = append(stack, n)
+	}
+
+	switch len(stack) {
+	case 0:
+		return 0, fmt.Errorf("%w: empty expression", ErrMalformedExpression)
+	case 1:
+		return stack[0], nil
+	default:
+		return 0, fmt.Errorf("%w: %d operands left over with no operator to combine them", ErrMalformedExpression, len(stack))
+	}
+}
+
+// isRPNNumber reports whether tok is written the way Eval's tokenizer reads numbers, allowing a
+// leading sign since postfix notation has no unary minus
+func isRPNNumber(tok string) bool {
+	if len(tok) > 0 && (tok[0] == '+' || tok[0] == '-') {
+		tok = tok[1:]
+	}
+
+	return tok != "" && (isDigit(tok[0]) || tok[0] == '.') && scanNumber(tok, 0) == len(tok)
+}
@@ -0,0 +1,60 @@
+package calculator
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEvalRPN(t *testing.T) {
+	testCases := []struct {
+		name     string
+		tokens   []string
+		expected float64
+	}{
+		{name: "Single number", tokens: []string{"42"}, expected: 42},
+		{name: "Add then multiply", tokens: []string{"3", "4", "+", "2", "*"}, expected: 14},
+		{name: "Operand order", tokens: []string{"10", "4", "-", "2", "/"}, expected: 3},
+		{name: "Nested", tokens: []string{"5", "1", "2", "+", "4", "*", "+", "3", "-"}, expected: 14},
+		{name: "Power", tokens: []string{"2", "10", "^"}, expected: 1024},
+		{name: "Negative numbers", tokens: []string{"-2", "-3.5", "*"}, expected: 7},
+		{name: "Signs and exponents", tokens: []string{"+1.5e2", ".5", "+"}, expected: 150.5},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			actual, err := EvalRPN(tc.tokens)
+
+			assert.NoError(tt, err)
+			assert.Equal(tt, tc.expected, actual)
+		})
+	}
+}
+
+func TestEvalRPNErrors(t *testing.T) {
+	testCases := []struct {
+		name     string
+		tokens   []string
+		expected error
+		msg      string
+	}{
+		{name: "Empty", tokens: nil, expected: ErrMalformedExpression, msg: "calculator: malformed expression: empty expression"},
+		{name: "Too few operands", tokens: []string{"1", "+"}, expected: ErrMalformedExpression, msg: `calculator: malformed expression: operator "+" at token 2 needs 2 operands, found 1`},
+		{name: "Leftover operands", tokens: []string{"1", "2", "3", "+"}, expected: ErrMalformedExpression, msg: "calculator: malformed expression: 2 operands left over with no operator to combine them"},
+		{name: "Unknown token", tokens: []string{"1", "2", "%"}, expected: ErrMalformedExpression, msg: `calculator: malformed expression: unknown token "%" at token 3`},
+		{name: "NaN", tokens: []string{"NaN"}, expected: ErrMalformedExpression, msg: `calculator: malformed expression: unknown token "NaN" at token 1`},
+		{name: "Infinity", tokens: []string{"1", "Inf", "+"}, expected: ErrMalformedExpression, msg: `calculator: malformed expression: unknown token "Inf" at token 2`},
+		{name: "Negative infinity", tokens: []string{"-Inf"}, expected: ErrMalformedExpression, msg: `calculator: malformed expression: unknown token "-Inf" at token 1`},
+		{name: "Hex float", tokens: []string{"0x1p3"}, expected: ErrMalformedExpression, msg: `calculator: malformed expression: unknown token "0x1p3" at token 1`},
+		{name: "Underscores", tokens: []string{"1_000"}, expected: ErrMalformedExpression, msg: `calculator: malformed expression: unknown token "1_000" at token 1`},
+		{name: "Divide by zero", tokens: []string{"1", "0", "/"}, expected: ErrDivideByZero, msg: "calculator: divide by zero at token 3"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			_, err := EvalRPN(tc.tokens)
+
+			assert.True(tt, errors.Is(err, tc.expected), "expected %v, got %v", tc.expected, err)
+			assert.EqualError(tt, err, tc.msg)
+		})
+	}
+}
@@ -0,0 +1,311 @@
+package calculator
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// madScale converts a median absolute deviation into a consistent estimator of the standard
+// deviation for normally distributed data
+const madScale = 0.6745
+
+// Mean returns the arithmetic mean of nums by dividing their sum by their count. This is the
+// fastest and usually the most accurate approach, but the sum can overflow to infinity when the
+// values are close to math.MaxFloat64; use SafeMean for data of that magnitude.
+func Mean(nums ...float64) (float64, error) {
+	if len(nums) == 0 {
+		return 0, ErrEmptyInput
+	}
+
+	var sum float64
+	for _, n := range nums {
+		sum += n
+	}
+
+	return sum / float64(len(nums)), nil
+}
+
+// SafeMean returns the arithmetic mean of nums, updating a running mean with each value instead of
+// building up a sum. The running mean never exceeds the largest magnitude in nums so it can't
+// overflow, at the cost of a division per value and slightly more rounding error than Mean on
+// ordinary data.
+func SafeMean(nums ...float64) (float64, error) {
+	if len(nums) == 0 {
+		return 0, ErrEmptyInput
+	}
+
+	var mean float64
+	for i, n := range nums {
+		mean += (n - mean) / float64(i+1)
+	}
+
+	return mean, nil
+}
+
+// Percentile returns the pth percentile of nums, where p is between 0 and 100. When the percentile
+// falls between two values it's linearly interpolated between them, so the 50th percentile is
+// always the median. nums is left in its original order.
+func Percentile(p float64, nums ...float64) (float64, error) {
+	if !(p >= 0 && p <= 100) {
+		return 0, fmt.Errorf("%w: percentile %g is not between 0 and 100", ErrOutOfRange, p)
+	}
+	if len(nums) == 0 {
+		return 0, ErrEmptyInput
+	}
+
+	sorted := make([]float64, len(nums))
+	copy(sorted, nums)
+	sort.Float64s(sorted)
+
+	rank := p / 100 * float64(len(sorted)-1)
+	lower := int(math.Floor(rank))
+	upper := int(math.Ceil(rank))
+	frac := rank - float64(lower)
+
+	return sorted[lower] + frac*(sorted[upper]-sorted[lower]), nil
+}
+
+// Variance returns the sample variance of nums, dividing the sum of squared deviations from the
+// mean by n-1 rather than n. This gives an unbiased estimate when nums is a sample drawn from a
+// larger population, and needs at least two values.
+func Variance(nums ...float64) (float64, error) {
+	if len(nums) < 2 {
+		return 0, fmt.Errorf("%w: sample variance needs at least 2 values, got %d", ErrInsufficientData, len(nums))
+	}
+
+	mean, err := Mean(nums...)
+	if err != nil {
+		return 0, err
+	}
+
+	var sq float64
+	for _, n := range nums {
+		sq += (n - mean) * (n - mean)
+	}
+
+	return sq / float64(len(nums)-1), nil
+}
+
+// StdDev returns the sample standard deviation of nums, the square root of Variance
+func StdDev(nums ...float64) (float64, error) {
+	variance, err := Variance(nums...)
+	if err != nil {
+		return 0, err
+	}
+
+	return math.Sqrt(variance), nil
+}
+
+// RobustZScores returns the modified z-score of each value, which measures its distance from the
+// median in units of the median absolute deviation (MAD). Unlike the classic z-score, outliers
+// don't drag the center and spread along with them, so they stand out clearly. Scores above 3.5
+// are commonly treated as outliers.
+func RobustZScores(nums []float64) ([]float64, error) {
+	if len(nums) == 0 {
+		return nil, ErrEmptyInput
+	}
+
+	med := median(nums)
+
+	deviations := make([]float64, len(nums))
+	for i, n := range nums {
+		deviations[i] = math.Abs(n - med)
+	}
+
+	mad := median(deviations)
+	if mad == 0 {
+		return nil, ErrZeroMAD
+	}
+
+	scores := make([]float64, len(nums))
+	for i, n := range nums {
+		scores[i] = madScale * (n - med) / mad
+	}
+
+	return scores, nil
+}
+
+// CumulativeStats returns the running sum and running mean of nums for each prefix, computed
+// together in a single pass. sums[i] and means[i] cover nums[0] through nums[i].
+func CumulativeStats(nums []float64) (sums, means []float64) {
+	sums = make([]float64, len(nums))
+	means = make([]float64, len(nums))
+
+	var sum float64
+	for i, n := range nums {
+		sum += n
+		sums[i] = sum
+		means[i] = sum / float64(i+1)
+	}
+
+	return sums, means
+}
+
+// MovingAverage returns the simple moving average of data over each run of window consecutive
+// values, so the result has len(data)-window+1 elements with element i averaging data[i] through
+// data[i+window-1]. A running sum keeps it O(n) however large the window. Empty data returns
+// ErrEmptyInput and a window smaller than 1 or longer than data returns ErrOutOfRange.
+func MovingAverage(data []float64, window int) ([]float64, error) {
+	if len(data) == 0 {
+		return nil, ErrEmptyInput
+	}
+	if window < 1 || window > len(data) {
+		return nil, fmt.Errorf("%w: window %d is not between 1 and %d", ErrOutOfRange, window, len(data))
+	}
+
+	var sum float64
+	for _, v := range data[:window] {
+		sum += v
+	}
+
+	averages := make([]float64, len(data)-window+1)
+	averages[0] = sum / float64(window)
+	for i := 1; i < len(averages); i++ {
+		sum += data[i+window-1] - data[i-1]
+		averages[i] = sum / float64(window)
+	}
+
+	return averages, nil
+}
+
+// SnapTo returns the element of sorted that is closest to x, preferring the smaller element when x
+// sits exactly between two of them. sorted must be in ascending order. The lookup itself is a
+// binary search, though confirming the order still visits every element.
+func SnapTo(x float64, sorted []float64) (float64, error) {
+	if len(sorted) == 0 {
+		return 0, ErrEmptyInput
+	}
+	if !sort.Float64sAreSorted(sorted) {
+		return 0, ErrUnsortedInput
+	}
+
+	// i is the first element that is >= x, so the closest is either it or the one before it
+	i := sort.SearchFloat64s(sorted, x)
+	switch {
+	case i == 0:
+		return sorted[0], nil
+	case i == len(sorted):
+		return sorted[len(sorted)-1], nil
+	case sorted[i]-x < x-sorted[i-1]:
+		return sorted[i], nil
+	default:
+		return sorted[i-1], nil
+	}
+}
+
+// WeightedMean returns the average of values with each one counting in proportion to its weight,
+// so values 90 and 80 weighted 0.7 and 0.3 average 87. The weights don't need to sum to 1.
+// Negative weights are allowed and pull the mean away from their values, but the weights must not
+// sum to zero, which returns ErrDivideByZero. Mismatched lengths return ErrLengthMismatch and
+// empty input returns ErrEmptyInput.
+func WeightedMean(values, weights []float64) (float64, error) {
+	if len(values) != len(weights) {
+		return 0, ErrLengthMismatch
+	}
+	if len(values) == 0 {
+		return 0, ErrEmptyInput
+	}
+
+	var sum, weightSum float64
+	for i, v := range values {
+		sum += v * weights[i]
+		weightSum += weights[i]
+	}
+
+	if weightSum == 0 {
+		return 0, ErrDivideByZero
+	}
+
+	return sum / weightSum, nil
+}
+
+// WeightedStdDev returns the weighted population standard deviation of values, where each value
+// counts in proportion to its weight. Unlike WeightedMean, negative weights return
+// ErrNegativeInput since they can make the variance negative, leaving no real deviation.
+func WeightedStdDev(values, weights []float64) (float64, error) {
+	for i, w := range weights {
+		if w < 0 {
+			return 0, fmt.Errorf("%w: weight %g at index %d", ErrNegativeInput, w, i)
+		}
+	}
+
+	mean, err := WeightedMean(values, weights)
+	if err != nil {
+		return 0, err
+	}
+
+	var weightSum, sq float64
+	for i, v := range values {
+		weightSum += weights[i]
+		sq += weights[i] * (v - mean) * (v - mean)
+	}
+
+	return math.Sqrt(sq / weightSum), nil
+}
+
+// SlopeThroughOrigin fits the line y = slope*x, which is forced through the origin, to the points
+// (xs[i], ys[i]) using least squares and returns the slope. That works out to sum(x*y) / sum(x*x),
+// which is undefined when every x is zero.
+func SlopeThroughOrigin(xs, ys []float64) (float64, error) {
+	if len(xs) != len(ys) {
+		return 0, ErrLengthMismatch
+	}
+	if len(xs) == 0 {
+		return 0, ErrEmptyInput
+	}
+
+	var sxy, sxx float64
+	for i, x := range xs {
+		sxy += x * ys[i]
+		sxx += x * x
+	}
+
+	if sxx == 0 {
+		return 0, ErrZeroVariance
+	}
+
+	return sxy / sxx, nil
+}
+
+// CumulativeDistribution converts histogram bin counts into a cumulative distribution, where each
+// entry is the fraction of all counts that fall in that bin or any bin before it. The final entry
+// is 1 up to rounding.
+func CumulativeDistribution(counts []int) ([]float64, error) {
+	if len(counts) == 0 {
+		return nil, ErrEmptyInput
+	}
+
+	var total int
+	for _, c := range counts {
+		total += c
+	}
+
+	if total == 0 {
+		return nil, ErrDivideByZero
+	}
+
+	cdf := make([]float64, len(counts))
+	var running int
+	for i, c := range counts {
+		running += c
+		cdf[i] = float64(running) / float64(total)
+	}
+
+	return cdf, nil
+}
+
+// median returns the middle value of nums without reordering the caller's slice. nums must not be
+// empty.
+func median(nums []float64) float64 {
+	sorted := make([]float64, len(nums))
+	copy(sorted, nums)
+	sort.Float64s(sorted)
+
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return (sorted[mid-1] + sorted[mid]) / 2
+	}
+
+	return sorted[mid]
+}
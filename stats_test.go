@@ -0,0 +1,475 @@
+package calculator
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRobustZScores(t *testing.T) {
+	nums := []float64{10, 12, 11, 13, 12, 11, 100}
+
+	actual, err := RobustZScores(nums)
+
+	// median is 12 and MAD is 1, so each score is simply 0.6745 * (x - 12)
+	expected := []float64{-1.349, 0, -0.6745, 0.6745, 0, -0.6745, 59.356}
+	assert.NoError(t, err)
+	assert.InDeltaSlice(t, expected, actual, 1e-9)
+	assert.Greater(t, actual[6], 3.5)
+	assert.Equal(t, []float64{10, 12, 11, 13, 12, 11, 100}, nums)
+}
+
+func TestRobustZScoresErrors(t *testing.T) {
+	testCases := []struct {
+		name     string
+		nums     []float64
+		expected error
+	}{
+		{name: "Empty", nums: nil, expected: ErrEmptyInput},
+		{name: "Uniform", nums: []float64{4, 4, 4, 4}, expected: ErrZeroMAD},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			_, err := RobustZScores(tc.nums)
+
+			assert.Equal(tt, tc.expected, err)
+		})
+	}
+}
+
+func TestCumulativeStats(t *testing.T) {
+	nums := []float64{2, 4, -3, 9}
+
+	sums, means := CumulativeStats(nums)
+
+	assert.Equal(t, []float64{2, 6, 3, 12}, sums)
+	assert.Equal(t, []float64{2, 3, 1, 3}, means)
+	assert.Equal(t, []float64{2, 4, -3, 9}, nums)
+}
+
+func TestCumulativeStatsEmpty(t *testing.T) {
+	sums, means := CumulativeStats(nil)
+
+	assert.Equal(t, []float64{}, sums)
+	assert.Equal(t, []float64{}, means)
+}
+
+func TestMovingAverage(t *testing.T) {
+	testCases := []struct {
+		name     string
+		data     []float64
+		window   int
+		expected []float64
+	}{
+		{name: "Window of 3", data: []float64{1, 2, 3, 4, 5, 6}, window: 3, expected: []float64{2, 3, 4, 5}},
+		{name: "With negatives", data: []float64{3, -3, 6, 0, 9}, window: 3, expected: []float64{2, 1, 5}},
+		{name: "Window of 1", data: []float64{4, 8, 15}, window: 1, expected: []float64{4, 8, 15}},
+		{name: "Whole series", data: []float64{4, 8, 15}, window: 3, expected: []float64{9}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			actual, err := MovingAverage(tc.data, tc.window)
+
+			assert.NoError(tt, err)
+			assert.Equal(tt, tc.expected, actual)
+		})
+	}
+}
+
+func TestMovingAverageMatchesNaive(t *testing.T) {
+	data := make([]float64, 1000)
+	for i := range data {
+		data[i] = math.Sin(float64(i)) * 100
+	}
+	const window = 7
+
+	naive := make([]float64, len(data)-window+1)
+	for i := range naive {
+		mean, err := Mean(data[i : i+window]...)
+		assert.NoError(t, err)
+		naive[i] = mean
+	}
+
+	actual, err := MovingAverage(data, window)
+
+	assert.NoError(t, err)
+	assert.InDeltaSlice(t, naive, actual, 1e-9)
+}
+
+func TestMovingAverageErrors(t *testing.T) {
+	testCases := []struct {
+		name     string
+		data     []float64
+		window   int
+		expected error
+	}{
+		{name: "Empty", data: []float64{}, window: 1, expected: ErrEmptyInput},
+		{name: "Zero window", data: []float64{1, 2}, window: 0, expected: ErrOutOfRange},
+		{name: "Negative window", data: []float64{1, 2}, window: -1, expected: ErrOutOfRange},
+		{name: "Window too long", data: []float64{1, 2}, window: 3, expected: ErrOutOfRange},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			_, err := MovingAverage(tc.data, tc.window)
+
+			assert.True(tt, errors.Is(err, tc.expected), "expected %v, got %v", tc.expected, err)
+		})
+	}
+}
+
+func TestSnapTo(t *testing.T) {
+	sorted := []float64{1, 5, 10, 20}
+
+	testCases := []struct {
+		name     string
+		x        float64
+		expected float64
+	}{
+		{name: "Closer to lower", x: 6, expected: 5},
+		{name: "Closer to upper", x: 9, expected: 10},
+		{name: "Exactly between", x: 15, expected: 10},
+		{name: "Exact match", x: 10, expected: 10},
+		{name: "Exact match first", x: 1, expected: 1},
+		{name: "Below range", x: -100, expected: 1},
+		{name: "Above range", x: 100, expected: 20},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			actual, err := SnapTo(tc.x, sorted)
+
+			assert.NoError(tt, err)
+			assert.Equal(tt, tc.expected, actual)
+		})
+	}
+}
+
+func TestSnapToErrors(t *testing.T) {
+	testCases := []struct {
+		name     string
+		sorted   []float64
+		expected error
+	}{
+		{name: "Empty", sorted: []float64{}, expected: ErrEmptyInput},
+		{name: "Unsorted", sorted: []float64{3, 1, 2}, expected: ErrUnsortedInput},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			_, err := SnapTo(2, tc.sorted)
+
+			assert.Equal(tt, tc.expected, err)
+		})
+	}
+}
+
+func TestWeightedMean(t *testing.T) {
+	testCases := []struct {
+		name     string
+		values   []float64
+		weights  []float64
+		expected float64
+	}{
+		{name: "Grades", values: []float64{90, 80}, weights: []float64{0.7, 0.3}, expected: 87},
+		{name: "Uniform weights", values: []float64{1, 2, 3, 4}, weights: []float64{2, 2, 2, 2}, expected: 2.5},
+		{name: "Frequency weights", values: []float64{2, 4, 5}, weights: []float64{1, 3, 1}, expected: 3.8},
+		{name: "Zero weight ignored", values: []float64{10, 1000}, weights: []float64{1, 0}, expected: 10},
+		{name: "Negative weight", values: []float64{10, 20}, weights: []float64{2, -1}, expected: 0},
+		{name: "Single value", values: []float64{5}, weights: []float64{3}, expected: 5},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			actual, err := WeightedMean(tc.values, tc.weights)
+
+			assert.NoError(tt, err)
+			assert.InDelta(tt, tc.expected, actual, 1e-9)
+		})
+	}
+}
+
+func TestWeightedMeanErrors(t *testing.T) {
+	testCases := []struct {
+		name     string
+		values   []float64
+		weights  []float64
+		expected error
+	}{
+		{name: "Length mismatch", values: []float64{1, 2}, weights: []float64{1}, expected: ErrLengthMismatch},
+		{name: "Empty", values: []float64{}, weights: []float64{}, expected: ErrEmptyInput},
+		{name: "Nil", expected: ErrEmptyInput},
+		{name: "Zero weights", values: []float64{1, 2}, weights: []float64{0, 0}, expected: ErrDivideByZero},
+		{name: "Weights cancel out", values: []float64{1, 2}, weights: []float64{1, -1}, expected: ErrDivideByZero},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			_, err := WeightedMean(tc.values, tc.weights)
+
+			assert.Equal(tt, tc.expected, err)
+		})
+	}
+}
+
+func TestWeightedStdDev(t *testing.T) {
+	testCases := []struct {
+		name     string
+		values   []float64
+		weights  []float64
+		expected float64
+	}{
+		{name: "Uniform weights", values: []float64{2, 4, 4, 4, 5, 5, 7, 9}, weights: []float64{1, 1, 1, 1, 1, 1, 1, 1}, expected: 2},
+		{name: "Frequency weights", values: []float64{2, 4, 5, 7, 9}, weights: []float64{1, 3, 2, 1, 1}, expected: 2},
+		{name: "Asymmetric weights", values: []float64{0, 10}, weights: []float64{3, 1}, expected: 4.330127018922193},
+		{name: "Single value", values: []float64{5}, weights: []float64{2}, expected: 0},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			actual, err := WeightedStdDev(tc.values, tc.weights)
+
+			assert.NoError(tt, err)
+			assert.InDelta(tt, tc.expected, actual, 1e-9)
+		})
+	}
+}
+
+func TestWeightedStdDevErrors(t *testing.T) {
+	testCases := []struct {
+		name     string
+		values   []float64
+		weights  []float64
+		expected error
+	}{
+		{name: "Length mismatch", values: []float64{1, 2}, weights: []float64{1}, expected: ErrLengthMismatch},
+		{name: "Empty", values: []float64{}, weights: []float64{}, expected: ErrEmptyInput},
+		{name: "Zero weight sum", values: []float64{1, 2}, weights: []float64{0, 0}, expected: ErrDivideByZero},
+		{name: "Negative weight", values: []float64{1, 10}, weights: []float64{-1, 2}, expected: ErrNegativeInput},
+		{name: "Weights cancel out", values: []float64{1, 2}, weights: []float64{1, -1}, expected: ErrNegativeInput},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			_, err := WeightedStdDev(tc.values, tc.weights)
+
+			assert.True(tt, errors.Is(err, tc.expected), "expected %v, got %v", tc.expected, err)
+		})
+	}
+}
+
+func TestMean(t *testing.T) {
+	actual, err := Mean(1, 2, 3, 4)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2.5, actual)
+
+	_, err = Mean()
+	assert.Equal(t, ErrEmptyInput, err)
+}
+
+func TestSafeMean(t *testing.T) {
+	testCases := []struct {
+		name     string
+		nums     []float64
+		expected float64
+	}{
+		{name: "Single value", nums: []float64{7}, expected: 7},
+		{name: "Ordinary values", nums: []float64{1, 2, 3, 4}, expected: 2.5},
+		{name: "Mixed signs", nums: []float64{-10, 10, -4, 8}, expected: 1},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			actual, err := SafeMean(tc.nums...)
+
+			assert.NoError(tt, err)
+			assert.InDelta(tt, tc.expected, actual, 1e-12)
+		})
+	}
+}
+
+func TestSafeMeanNearMaxFloat(t *testing.T) {
+	nums := []float64{math.MaxFloat64, math.MaxFloat64 * 0.5, math.MaxFloat64}
+
+	naive, err := Mean(nums...)
+	assert.NoError(t, err)
+	assert.True(t, math.IsInf(naive, 1), "expected the naive mean to overflow, got %g", naive)
+
+	actual, err := SafeMean(nums...)
+	assert.NoError(t, err)
+	assert.InEpsilon(t, math.MaxFloat64*(2.5/3), actual, 1e-12)
+}
+
+func TestSafeMeanEmpty(t *testing.T) {
+	_, err := SafeMean()
+
+	assert.Equal(t, ErrEmptyInput, err)
+}
+
+func TestSlopeThroughOrigin(t *testing.T) {
+	testCases := []struct {
+		name     string
+		xs       []float64
+		ys       []float64
+		expected float64
+	}{
+		{name: "Proportional", xs: []float64{1, 2, 3, 4}, ys: []float64{2.5, 5, 7.5, 10}, expected: 2.5},
+		{name: "Negative slope", xs: []float64{-2, 1, 3}, ys: []float64{6, -3, -9}, expected: -3},
+		// sum(xy) = 2.1 + 3.8 + 6.3 = 12.2 and sum(xx) = 1 + 4 + 9 = 14
+		{name: "Noisy", xs: []float64{1, 2, 3}, ys: []float64{2.1, 1.9, 2.1}, expected: 12.2 / 14},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			actual, err := SlopeThroughOrigin(tc.xs, tc.ys)
+
+			assert.NoError(tt, err)
+			assert.InDelta(tt, tc.expected, actual, 1e-12)
+		})
+	}
+}
+
+func TestSlopeThroughOriginErrors(t *testing.T) {
+	testCases := []struct {
+		name     string
+		xs       []float64
+		ys       []float64
+		expected error
+	}{
+		{name: "Length mismatch", xs: []float64{1, 2}, ys: []float64{1}, expected: ErrLengthMismatch},
+		{name: "Empty", xs: []float64{}, ys: []float64{}, expected: ErrEmptyInput},
+		{name: "All xs zero", xs: []float64{0, 0}, ys: []float64{1, 2}, expected: ErrZeroVariance},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			_, err := SlopeThroughOrigin(tc.xs, tc.ys)
+
+			assert.Equal(tt, tc.expected, err)
+		})
+	}
+}
+
+func TestCumulativeDistribution(t *testing.T) {
+	testCases := []struct {
+		name     string
+		counts   []int
+		expected []float64
+	}{
+		{name: "Uniform", counts: []int{5, 5, 5, 5}, expected: []float64{0.25, 0.5, 0.75, 1}},
+		{name: "Skewed", counts: []int{8, 1, 0, 1}, expected: []float64{0.8, 0.9, 0.9, 1}},
+		{name: "Single bin", counts: []int{3}, expected: []float64{1}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			actual, err := CumulativeDistribution(tc.counts)
+
+			assert.NoError(tt, err)
+			assert.InDeltaSlice(tt, tc.expected, actual, 1e-12)
+		})
+	}
+}
+
+func TestCumulativeDistributionErrors(t *testing.T) {
+	testCases := []struct {
+		name     string
+		counts   []int
+		expected error
+	}{
+		{name: "No bins", counts: []int{}, expected: ErrEmptyInput},
+		{name: "Zero total", counts: []int{0, 0, 0}, expected: ErrDivideByZero},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			_, err := CumulativeDistribution(tc.counts)
+
+			assert.Equal(tt, tc.expected, err)
+		})
+	}
+}
+
+func TestPercentile(t *testing.T) {
+	nums := []float64{15, 20, 35, 40, 50}
+
+	testCases := []struct {
+		name     string
+		p        float64
+		expected float64
+	}{
+		{name: "0th", p: 0, expected: 15},
+		{name: "25th", p: 25, expected: 20},
+		{name: "40th", p: 40, expected: 29},
+		{name: "50th", p: 50, expected: 35},
+		{name: "90th", p: 90, expected: 46},
+		{name: "100th", p: 100, expected: 50},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			actual, err := Percentile(tc.p, nums...)
+
+			assert.NoError(tt, err)
+			assert.InDelta(tt, tc.expected, actual, 1e-12)
+		})
+	}
+
+	assert.Equal(t, []float64{15, 20, 35, 40, 50}, nums)
+}
+
+func TestPercentileIsMedian(t *testing.T) {
+	nums := []float64{9, 1, 7, 3}
+
+	actual, err := Percentile(50, nums...)
+
+	assert.NoError(t, err)
+	assert.Equal(t, median(nums), actual)
+	assert.Equal(t, []float64{9, 1, 7, 3}, nums)
+}
+
+func TestPercentileErrors(t *testing.T) {
+	testCases := []struct {
+		name     string
+		p        float64
+		nums     []float64
+		expected error
+	}{
+		{name: "Below range", p: -1, nums: []float64{1}, expected: ErrOutOfRange},
+		{name: "Above range", p: 100.5, nums: []float64{1}, expected: ErrOutOfRange},
+		{name: "NaN", p: math.NaN(), nums: []float64{1}, expected: ErrOutOfRange},
+		{name: "Empty", p: 50, nums: nil, expected: ErrEmptyInput},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			_, err := Percentile(tc.p, tc.nums...)
+
+			assert.True(tt, errors.Is(err, tc.expected), "expected %v, got %v", tc.expected, err)
+		})
+	}
+}
+
+func TestVarianceAndStdDev(t *testing.T) {
+	testCases := []struct {
+		name     string
+		nums     []float64
+		variance float64
+		stdDev   float64
+	}{
+		// mean is 5 and the squared deviations sum to 32, so the variance is 32/7
+		{name: "Known dataset", nums: []float64{2, 4, 4, 4, 5, 5, 7, 9}, variance: 32.0 / 7, stdDev: 2.138089935299395},
+		{name: "Two values", nums: []float64{1, 3}, variance: 2, stdDev: math.Sqrt2},
+		{name: "No spread", nums: []float64{6, 6, 6}, variance: 0, stdDev: 0},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			variance, err := Variance(tc.nums...)
+			assert.NoError(tt, err)
+			assert.InDelta(tt, tc.variance, variance, 1e-12)
+
+			stdDev, err := StdDev(tc.nums...)
+			assert.NoError(tt, err)
+			assert.InDelta(tt, tc.stdDev, stdDev, 1e-12)
+		})
+	}
+}
+
+func TestVarianceInsufficientData(t *testing.T) {
+	for _, nums := range [][]float64{nil, {1}} {
+		_, err := Variance(nums...)
+		assert.True(t, errors.Is(err, ErrInsufficientData))
+
+		_, err = StdDev(nums...)
+		assert.True(t, errors.Is(err, ErrInsufficientData))
+	}
+}
@@ -0,0 +1,124 @@
+package calculator
+
+import (
+	"fmt"
+	"math"
+)
+
+// Log returns the natural logarithm of x or ErrNonPositiveInput if x <= 0 or is NaN
+func Log(x float64) (float64, error) {
+	if !(x > 0) {
+		return 0, ErrNonPositiveInput
+	}
+
+	return math.Log(x), nil
+}
+
+// Log10 returns the base 10 logarithm of x or ErrNonPositiveInput if x <= 0 or is NaN
+func Log10(x float64) (float64, error) {
+	if !(x > 0) {
+		return 0, ErrNonPositiveInput
+	}
+
+	return math.Log10(x), nil
+}
+
+// LogBase returns the logarithm of x in the given base. It returns ErrNonPositiveInput if x <= 0
+// and ErrInvalidBase if base <= 0 or base == 1. A NaN x or base counts as out of range too.
+func LogBase(x, base float64) (float64, error) {
+	if !(x > 0) {
+		return 0, ErrNonPositiveInput
+	}
+	if !(base > 0) || base == 1 {
+		return 0, ErrInvalidBase
+	}
+
+	// math.Log2 is exact for powers of two, which a ratio of natural logs isn't
+	if base == 2 {
+		return math.Log2(x), nil
+	}
+
+	return math.Log(x) / math.Log(base), nil
+}
+
+// DegToRad converts an angle in degrees to radians
+func DegToRad(deg float64) float64 {
+	return deg * math.Pi / 180
+}
+
+// RadToDeg converts an angle in radians to degrees
+func RadToDeg(rad float64) float64 {
+	return rad * 180 / math.Pi
+}
+
+// Sin returns the sine of an angle in radians
+func Sin(rad float64) float64 {
+	return math.Sin(rad)
+}
+
+// Cos returns the cosine of an angle in radians
+func Cos(rad float64) float64 {
+	return math.Cos(rad)
+}
+
+// Tan returns the tangent of an angle in radians
+func Tan(rad float64) float64 {
+	return math.Tan(rad)
+}
+
+// SinDeg returns the sine of an angle in degrees
+func SinDeg(deg float64) float64 {
+	return Sin(DegToRad(deg))
+}
+
+// CosDeg returns the cosine of an angle in degrees
+func CosDeg(deg float64) float64 {
+	return Cos(DegToRad(deg))
+}
+
+// TanDeg returns the tangent of an angle in degrees. The tangent is undefined at odd multiples of
+// 90 degrees, but since π/2 can't be represented exactly those angles land just beside the
+// asymptote and return a very large finite value (about 1.6e16 for 90) instead of infinity.
+func TanDeg(deg float64) float64 {
+	return Tan(DegToRad(deg))
+}
+
+// Sinh returns the hyperbolic sine of x
+func Sinh(x float64) float64 {
+	return math.Sinh(x)
+}
+
+// Cosh returns the hyperbolic cosine of x
+func Cosh(x float64) float64 {
+	return math.Cosh(x)
+}
+
+// Tanh returns the hyperbolic tangent of x
+func Tanh(x float64) float64 {
+	return math.Tanh(x)
+}
+
+// Asinh returns the inverse hyperbolic sine of x
+func Asinh(x float64) float64 {
+	return math.Asinh(x)
+}
+
+// Acosh returns the inverse hyperbolic cosine of x, which is only defined for x >= 1. Other inputs,
+// including NaN, return ErrOutOfRange.
+func Acosh(x float64) (float64, error) {
+	if !(x >= 1) {
+		return 0, fmt.Errorf("%w: acosh is only defined for x >= 1, got %g", ErrOutOfRange, x)
+	}
+
+	return math.Acosh(x), nil
+}
+
+// Atanh returns the inverse hyperbolic tangent of x, which is only defined for -1 < x < 1. Other
+// inputs, including NaN, return ErrOutOfRange rather than the infinities or NaN math.Atanh gives.
+func Atanh(x float64) (float64, error) {
+	if !(math.Abs(x) < 1) {
+		return 0, fmt.Errorf("%w: atanh is only defined for -1 < x < 1, got %g", ErrOutOfRange, x)
+	}
+
+	return math.Atanh(x), nil
+}
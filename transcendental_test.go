@@ -0,0 +1,171 @@
+package calculator
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// epsilon is the tolerance used when comparing the results of transcendental functions
+const epsilon = 1e-12
+
+func TestLogarithms(t *testing.T) {
+	testCases := []struct {
+		name     string
+		log      func() (float64, error)
+		expected float64
+	}{
+		{name: "Log of 1", log: func() (float64, error) { return Log(1) }, expected: 0},
+		{name: "Log of e", log: func() (float64, error) { return Log(math.E) }, expected: 1},
+		{name: "Log10 of 1000", log: func() (float64, error) { return Log10(1000) }, expected: 3},
+		{name: "Log10 of 0.01", log: func() (float64, error) { return Log10(0.01) }, expected: -2},
+		{name: "LogBase 2 of 8", log: func() (float64, error) { return LogBase(8, 2) }, expected: 3},
+		{name: "LogBase 2 of 0.5", log: func() (float64, error) { return LogBase(0.5, 2) }, expected: -1},
+		{name: "LogBase 3 of 81", log: func() (float64, error) { return LogBase(81, 3) }, expected: 4},
+		{name: "LogBase 0.5 of 4", log: func() (float64, error) { return LogBase(4, 0.5) }, expected: -2},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			actual, err := tc.log()
+
+			assert.NoError(tt, err)
+			assert.InDelta(tt, tc.expected, actual, epsilon)
+		})
+	}
+}
+
+func TestLogarithmDomainErrors(t *testing.T) {
+	testCases := []struct {
+		name     string
+		log      func() (float64, error)
+		expected error
+	}{
+		{name: "Log of 0", log: func() (float64, error) { return Log(0) }, expected: ErrNonPositiveInput},
+		{name: "Log of negative", log: func() (float64, error) { return Log(-1) }, expected: ErrNonPositiveInput},
+		{name: "Log10 of 0", log: func() (float64, error) { return Log10(0) }, expected: ErrNonPositiveInput},
+		{name: "LogBase of negative", log: func() (float64, error) { return LogBase(-8, 2) }, expected: ErrNonPositiveInput},
+		{name: "LogBase base 1", log: func() (float64, error) { return LogBase(8, 1) }, expected: ErrInvalidBase},
+		{name: "LogBase base 0", log: func() (float64, error) { return LogBase(8, 0) }, expected: ErrInvalidBase},
+		{name: "LogBase negative base", log: func() (float64, error) { return LogBase(8, -2) }, expected: ErrInvalidBase},
+		{name: "Log of NaN", log: func() (float64, error) { return Log(math.NaN()) }, expected: ErrNonPositiveInput},
+		{name: "Log10 of NaN", log: func() (float64, error) { return Log10(math.NaN()) }, expected: ErrNonPositiveInput},
+		{name: "LogBase of NaN", log: func() (float64, error) { return LogBase(math.NaN(), 3) }, expected: ErrNonPositiveInput},
+		{name: "LogBase NaN base", log: func() (float64, error) { return LogBase(8, math.NaN()) }, expected: ErrInvalidBase},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			_, err := tc.log()
+
+			assert.Equal(tt, tc.expected, err)
+		})
+	}
+}
+
+func TestTrigDegrees(t *testing.T) {
+	testCases := []struct {
+		deg float64
+		sin float64
+		cos float64
+		tan float64
+	}{
+		{deg: 0, sin: 0, cos: 1, tan: 0},
+		{deg: 30, sin: 0.5, cos: math.Sqrt(3) / 2, tan: 1 / math.Sqrt(3)},
+		{deg: 45, sin: math.Sqrt2 / 2, cos: math.Sqrt2 / 2, tan: 1},
+		{deg: -45, sin: -math.Sqrt2 / 2, cos: math.Sqrt2 / 2, tan: -1},
+		{deg: 180, sin: 0, cos: -1, tan: 0},
+	}
+	for _, tc := range testCases {
+		t.Run(strconv.FormatFloat(tc.deg, 'f', -1, 64), func(tt *testing.T) {
+			assert.InDelta(tt, tc.sin, SinDeg(tc.deg), epsilon)
+			assert.InDelta(tt, tc.cos, CosDeg(tc.deg), epsilon)
+			assert.InDelta(tt, tc.tan, TanDeg(tc.deg), epsilon)
+
+			rad := DegToRad(tc.deg)
+			assert.InDelta(tt, tc.sin, Sin(rad), epsilon)
+			assert.InDelta(tt, tc.cos, Cos(rad), epsilon)
+			assert.InDelta(tt, tc.tan, Tan(rad), epsilon)
+		})
+	}
+}
+
+func TestTrigDegrees90(t *testing.T) {
+	assert.InDelta(t, 1, SinDeg(90), epsilon)
+	assert.InDelta(t, 0, CosDeg(90), epsilon)
+	assert.Greater(t, TanDeg(90), 1e15)
+}
+
+func TestAngleConversion(t *testing.T) {
+	assert.InDelta(t, math.Pi, DegToRad(180), epsilon)
+	assert.InDelta(t, 90, RadToDeg(math.Pi/2), epsilon)
+
+	for _, deg := range []float64{0, 30, 45, 90, -720, 1234.5} {
+		assert.InDelta(t, deg, RadToDeg(DegToRad(deg)), epsilon)
+	}
+}
+
+func TestHyperbolic(t *testing.T) {
+	testCases := []struct {
+		x    float64
+		sinh float64
+		cosh float64
+		tanh float64
+	}{
+		{x: 0, sinh: 0, cosh: 1, tanh: 0},
+		{x: 1, sinh: (math.E - 1/math.E) / 2, cosh: (math.E + 1/math.E) / 2, tanh: (math.E*math.E - 1) / (math.E*math.E + 1)},
+		{x: -1, sinh: -(math.E - 1/math.E) / 2, cosh: (math.E + 1/math.E) / 2, tanh: -(math.E*math.E - 1) / (math.E*math.E + 1)},
+	}
+	for _, tc := range testCases {
+		t.Run(strconv.FormatFloat(tc.x, 'f', -1, 64), func(tt *testing.T) {
+			assert.InDelta(tt, tc.sinh, Sinh(tc.x), epsilon)
+			assert.InDelta(tt, tc.cosh, Cosh(tc.x), epsilon)
+			assert.InDelta(tt, tc.tanh, Tanh(tc.x), epsilon)
+
+			assert.InDelta(tt, tc.x, Asinh(Sinh(tc.x)), epsilon)
+
+			atanh, err := Atanh(Tanh(tc.x))
+			assert.NoError(tt, err)
+			assert.InDelta(tt, tc.x, atanh, epsilon)
+
+			acosh, err := Acosh(Cosh(tc.x))
+			assert.NoError(tt, err)
+			assert.InDelta(tt, math.Abs(tc.x), acosh, epsilon)
+		})
+	}
+}
+
+func TestInverseHyperbolic(t *testing.T) {
+	acosh, err := Acosh(1)
+	assert.NoError(t, err)
+	assert.Equal(t, 0.0, acosh)
+
+	atanh, err := Atanh(0.5)
+	assert.NoError(t, err)
+	assert.InDelta(t, 0.5493061443340549, atanh, epsilon)
+
+	assert.InDelta(t, 0.881373587019543, Asinh(1), epsilon)
+}
+
+func TestInverseHyperbolicDomainErrors(t *testing.T) {
+	testCases := []struct {
+		name string
+		run  func() (float64, error)
+	}{
+		{name: "Acosh below 1", run: func() (float64, error) { return Acosh(0.999) }},
+		{name: "Acosh negative", run: func() (float64, error) { return Acosh(-2) }},
+		{name: "Acosh NaN", run: func() (float64, error) { return Acosh(math.NaN()) }},
+		{name: "Atanh at 1", run: func() (float64, error) { return Atanh(1) }},
+		{name: "Atanh at -1", run: func() (float64, error) { return Atanh(-1) }},
+		{name: "Atanh beyond 1", run: func() (float64, error) { return Atanh(1.5) }},
+		{name: "Atanh NaN", run: func() (float64, error) { return Atanh(math.NaN()) }},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			_, err := tc.run()
+
+			assert.True(tt, errors.Is(err, ErrOutOfRange), "expected ErrOutOfRange, got %v", err)
+		})
+	}
+}
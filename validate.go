@@ -0,0 +1,87 @@
+package calculator
+
+import (
+	"context"
+	"fmt"
+)
+
+// ValidateExpression checks that expr is well formed without evaluating it, so a UI can flag
+// mistakes as the user types. It catches illegal characters, malformed numbers, unbalanced
+// parentheses and operators or commas in places they can't go, such as "3 + * 4", returning a
+// *SyntaxError with the column of the problem, as Eval would. It doesn't check that called
+// functions exist, since they may be registered later, or catch errors that depend on values such
+// as dividing by zero, so a nil result doesn't guarantee Eval will succeed.
+func ValidateExpression(expr string) error {
+	tokens, err := tokenize(context.Background(), expr)
+	if err != nil {
+		return err
+	}
+
+	// parens holds the open parentheses not yet closed, innermost last
+	type paren struct {
+		col  int
+		call bool
+	}
+	var parens []paren
+
+	// expectOperand is true wherever a number, call or "(" must come next
+	expectOperand := true
+	for i, tok := range tokens {
+		unexpected := &SyntaxError{Column: tok.col, Msg: fmt.Sprintf("unexpected %q", tok.text)}
+
+		switch tok.kind {
+		case tokenNumber:
+			if !expectOperand {
+				return unexpected
+			}
+			expectOperand = false
+		case tokenIdent:
+			if !expectOperand {
+				return unexpected
+			}
+			if i+1 >= len(tokens) || tokens[i+1].kind != tokenLeftParen {
+				return &SyntaxError{Column: tok.col, Msg: fmt.Sprintf("expected \"(\" after %q", tok.text)}
+			}
+		case tokenOperator:
+			// Only + and - can appear where an operand is expected, as unary operators
+			if expectOperand && tok.text != "+" && tok.text != "-" {
+				return unexpected
+			}
+			expectOperand = true
+		case tokenLeftParen:
+			call := i > 0 && tokens[i-1].kind == tokenIdent
+			if !expectOperand && !call {
+				return unexpected
+			}
+			parens = append(parens, paren{col: tok.col, call: call})
+			expectOperand = true
+		case tokenRightParen:
+			if len(parens) == 0 {
+				return unexpected
+			}
+
+			// A call is the only place an empty pair of parentheses is allowed
+			open := parens[len(parens)-1]
+			emptyCall := open.call && tokens[i-1].kind == tokenLeftParen
+			if expectOperand && !emptyCall {
+				return unexpected
+			}
+			parens = parens[:len(parens)-1]
+			expectOperand = false
+		case tokenComma:
+			if expectOperand || len(parens) == 0 || !parens[len(parens)-1].call {
+				return unexpected
+			}
+			expectOperand = true
+		}
+	}
+
+	if expectOperand {
+		return &SyntaxError{Column: len(expr) + 1, Msg: "unexpected end of expression"}
+	}
+	if len(parens) > 0 {
+		return &SyntaxError{Column: parens[len(parens)-1].col, Msg: "unclosed parenthesis"}
+	}
+
+	return nil
+}
@@ -0,0 +1,99 @@
+package calculator
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateExpression(t *testing.T) {
+	exprs := []string{
+		"42",
+		"2 + 3 * 4",
+		"(2 + 3) * 4",
+		"((1))",
+		"-3 + -(2 * 2)",
+		"- - 3",
+		"2 ^ -3",
+		"1.5e2 + .5",
+		"sqrt(16) + abs(-1)",
+		"max(1, 2 * 3, (4 - 5))",
+		"undefined(1)",
+		"pi()",
+		"1 / 0",
+	}
+	for _, expr := range exprs {
+		t.Run(expr, func(tt *testing.T) {
+			assert.NoError(tt, ValidateExpression(expr))
+		})
+	}
+}
+
+func TestValidateExpressionErrors(t *testing.T) {
+	testCases := []struct {
+		name   string
+		expr   string
+		column int
+		msg    string
+	}{
+		{name: "Empty", expr: "", column: 1, msg: "unexpected end of expression"},
+		{name: "Blank", expr: "   ", column: 4, msg: "unexpected end of expression"},
+		{name: "Trailing operator", expr: "1 +", column: 4, msg: "unexpected end of expression"},
+		{name: "Operator run", expr: "3 + * 4", column: 5, msg: `unexpected "*"`},
+		{name: "Leading operator", expr: "* 4", column: 1, msg: `unexpected "*"`},
+		{name: "Double power", expr: "2 ^ ^ 3", column: 5, msg: `unexpected "^"`},
+		{name: "Adjacent numbers", expr: "3 4", column: 3, msg: `unexpected "4"`},
+		{name: "Illegal character", expr: "1 + 2 $ 3", column: 7, msg: `unexpected character '$'`},
+		{name: "Invalid number", expr: "1.2.3", column: 1, msg: `invalid number "1.2.3"`},
+		{name: "Unclosed parenthesis", expr: "(1 + 2", column: 1, msg: "unclosed parenthesis"},
+		{name: "Innermost unclosed parenthesis", expr: "(1 + (2", column: 6, msg: "unclosed parenthesis"},
+		{name: "Outer unclosed parenthesis", expr: "((1)", column: 1, msg: "unclosed parenthesis"},
+		{name: "Extra closing parenthesis", expr: "1 + 2)", column: 6, msg: `unexpected ")"`},
+		{name: "Closing before opening", expr: ")(", column: 1, msg: `unexpected ")"`},
+		{name: "Empty parentheses", expr: "()", column: 2, msg: `unexpected ")"`},
+		{name: "Operator before closing", expr: "(1 +)", column: 5, msg: `unexpected ")"`},
+		{name: "Implicit multiplication", expr: "2(3)", column: 2, msg: `unexpected "("`},
+		{name: "Function without parentheses", expr: "sqrt 4", column: 1, msg: `expected "(" after "sqrt"`},
+		{name: "Trailing comma", expr: "sqrt(1,)", column: 8, msg: `unexpected ")"`},
+		{name: "Comma outside call", expr: "1, 2", column: 2, msg: `unexpected ","`},
+		{name: "Comma in grouping", expr: "(1, 2)", column: 3, msg: `unexpected ","`},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			err := ValidateExpression(tc.expr)
+
+			var syntaxErr *SyntaxError
+			if assert.True(tt, errors.As(err, &syntaxErr), "expected a SyntaxError, got %v", err) {
+				assert.Equal(tt, tc.column, syntaxErr.Column)
+				assert.Equal(tt, tc.msg, syntaxErr.Msg)
+			}
+		})
+	}
+}
+
+// Where Eval rejects an expression's syntax, ValidateExpression should report the same problem
+func TestValidateExpressionMatchesEval(t *testing.T) {
+	exprs := []string{
+		"",
+		"1 +",
+		"3 + * 4",
+		"3 4",
+		"1 + 2 $ 3",
+		"1.2.3",
+		"(1 + 2",
+		"(1 + (2",
+		"((1)",
+		"1 + 2)",
+		"()",
+		"sqrt 4",
+		"sqrt(1,)",
+	}
+	for _, expr := range exprs {
+		t.Run(expr, func(tt *testing.T) {
+			_, evalErr := Eval(expr)
+
+			assert.Equal(tt, evalErr, ValidateExpression(expr))
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package calculator
+
+import "math"
+
+// ManhattanDistance returns the sum of the absolute differences between the coordinates of a and b
+func ManhattanDistance(a, b []float64) (float64, error) {
+	if len(a) != len(b) {
+		return 0, ErrLengthMismatch
+	}
+
+	var dist float64
+	for i := range a {
+		dist += math.Abs(a[i] - b[i])
+	}
+
+	return dist, nil
+}
+
+// ChebyshevDistance returns the largest absolute difference between any pair of coordinates in a
+// and b
+func ChebyshevDistance(a, b []float64) (float64, error) {
+	if len(a) != len(b) {
+		return 0, ErrLengthMismatch
+	}
+
+	var dist float64
+	for i := range a {
+		dist = math.Max(dist, math.Abs(a[i]-b[i]))
+	}
+
+	return dist, nil
+}
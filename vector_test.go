@@ -0,0 +1,61 @@
+package calculator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestManhattanDistance(t *testing.T) {
+	testCases := []struct {
+		name     string
+		a        []float64
+		b        []float64
+		expected float64
+	}{
+		{name: "2D", a: []float64{1, 2}, b: []float64{4, 6}, expected: 7},
+		{name: "Higher dimensional", a: []float64{1, -2, 3, 0}, b: []float64{-1, 2, 3, 5}, expected: 11},
+		{name: "Identical points", a: []float64{3, 3, 3}, b: []float64{3, 3, 3}, expected: 0},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			actual, err := ManhattanDistance(tc.a, tc.b)
+
+			assert.NoError(tt, err)
+			assert.Equal(tt, tc.expected, actual)
+		})
+	}
+}
+
+func TestManhattanDistanceLengthMismatch(t *testing.T) {
+	_, err := ManhattanDistance([]float64{1, 2}, []float64{1, 2, 3})
+
+	assert.Equal(t, ErrLengthMismatch, err)
+}
+
+func TestChebyshevDistance(t *testing.T) {
+	testCases := []struct {
+		name     string
+		a        []float64
+		b        []float64
+		expected float64
+	}{
+		{name: "One dimension dominates", a: []float64{0, 0, 0}, b: []float64{1, -9, 2}, expected: 9},
+		{name: "2D", a: []float64{1, 2}, b: []float64{4, 6}, expected: 4},
+		{name: "Identical points", a: []float64{3, 3, 3}, b: []float64{3, 3, 3}, expected: 0},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			actual, err := ChebyshevDistance(tc.a, tc.b)
+
+			assert.NoError(tt, err)
+			assert.Equal(tt, tc.expected, actual)
+		})
+	}
+}
+
+func TestChebyshevDistanceLengthMismatch(t *testing.T) {
+	_, err := ChebyshevDistance([]float64{1}, []float64{})
+
+	assert.Equal(t, ErrLengthMismatch, err)
+}
@@ -0,0 +1,174 @@
+package calculator
+
+import (
+	"fmt"
+	"math"
+)
+
+// Epsilon is the tolerance Compare uses when deciding that two numbers are equal
+const Epsilon = 1e-9
+
+// Cruncher is a NumberCruncher backed by the package level functions
+type Cruncher struct{}
+
+// Add sums two numbers using the package level Add
+func (Cruncher) Add(x, y float64) float64 {
+	return Add(x, y)
+}
+
+// Verify checks a result using the package level Verify
+func (Cruncher) Verify(got, want float64) bool {
+	return Verify(got, want)
+}
+
+// VerifyAddTable runs every case through impl.Add and returns one descriptive error per case whose
+// result isn't within epsilon of Want. A NaN result never matches. No errors means every case
+// passed.
+func VerifyAddTable(impl NumberCruncher, cases []struct{ X, Y, Want float64 }, epsilon float64) []error {
+	var errs []error
+	for i, c := range cases {
+		got := impl.Add(c.X, c.Y)
+
+		if !(math.Abs(got-c.Want) <= epsilon) {
+			errs = append(errs, fmt.Errorf("case %d: Add(%g, %g) = %g, want %g within %g", i, c.X, c.Y, got, c.Want, epsilon))
+		}
+	}
+
+	return errs
+}
+
+// Compare returns -1 if a is less than b, +1 if a is greater than b and 0 if they're within
+// Epsilon of each other. NaN is treated as greater than every number and equal to itself, so
+// sorting with Compare is deterministic and places NaNs last.
+func Compare(a, b float64) int {
+	switch aNaN, bNaN := math.IsNaN(a), math.IsNaN(b); {
+	case aNaN && bNaN:
+		return 0
+	case aNaN:
+		return 1
+	case bNaN:
+		return -1
+	}
+
+	// Checking for exact equality first handles infinities, whose difference is NaN
+	if a == b || math.Abs(a-b) <= Epsilon {
+		return 0
+	}
+	if a < b {
+		return -1
+	}
+
+	return 1
+}
+
+// VerifySlice reports whether got and want have the same length and every pair of elements is
+// within epsilon of each other. Two empty slices are equal. NaN only matches NaN in the same
+// position, which lets tests expect a NaN result at a specific index.
+func VerifySlice(got, want []float64, epsilon float64) bool {
+	if len(got) != len(want) {
+		return false
+	}
+
+	for i := range got {
+		if math.IsNaN(got[i]) || math.IsNaN(want[i]) {
+			if math.IsNaN(got[i]) != math.IsNaN(want[i]) {
+				return false
+			}
+			continue
+		}
+
+		if got[i] != want[i] && !(math.Abs(got[i]-want[i]) <= epsilon) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// VerifyDetailed reports whether got is within epsilon of want like EpsilonVerify, along with
+// their difference got - want and, when they don't match, a message explaining why, such as
+// "got 3.14, want 3.1, diff 0.04 exceeds epsilon 0.001". The diff in the message is rounded to 6
+// significant figures so float noise doesn't swamp it. The message is empty on success.
+func VerifyDetailed(got, want, epsilon float64) (ok bool, diff float64, msg string) {
+	diff = got - want
+	if (EpsilonVerify{Epsilon: epsilon}).Verify(got, want) {
+		return true, diff, ""
+	}
+
+	return false, diff, fmt.Sprintf("got %g, want %g, diff %.6g exceeds epsilon %g", got, want, diff, epsilon)
+}
+
+// EqualULP reports whether a and b are at most maxULPs units in the last place apart, that is
+// whether there are no more than maxULPs-1 representable float64 values between them. Unlike a
+// fixed epsilon this scales with magnitude, so it works equally well for 1e-300 and 1e300. Values
+// of opposite sign are compared across zero, positive and negative zero are equal, and NaN never
+// equals anything. The largest finite float64 counts as 1 ULP from infinity.
+func EqualULP(a, b float64, maxULPs uint) bool {
+	if a == b {
+		return true
+	}
+	if math.IsNaN(a) || math.IsNaN(b) {
+		return false
+	}
+
+	x, y := orderedBits(a), orderedBits(b)
+	if x < y {
+		x, y = y, x
+	}
+
+	return x-y <= uint64(maxULPs)
+}
+
+// orderedBits maps x onto a uint64 that sorts in the same order as the float64 values, with
+// adjacent floats mapping to adjacent integers and both zeros mapping to 1<<63
+func orderedBits(x float64) uint64 {
+	const signBit = 1 << 63
+
+	bits := math.Float64bits(x)
+	if bits&signBit != 0 {
+		return signBit - bits&^signBit
+	}
+
+	return signBit + bits
+}
+
+// VerifyStrategy decides whether a computed result matches the expected one
+type VerifyStrategy interface {
+	Verify(got, want float64) bool
+}
+
+// ExactVerify only accepts results exactly equal to the expected value
+type ExactVerify struct{}
+
+// Verify reports whether got == want
+func (ExactVerify) Verify(got, want float64) bool {
+	return got == want
+}
+
+// EpsilonVerify accepts results within a fixed absolute distance of the expected value. This suits
+// values of a known, modest magnitude.
+type EpsilonVerify struct {
+	Epsilon float64
+}
+
+// Verify reports whether got is within e.Epsilon of want
+func (e EpsilonVerify) Verify(got, want float64) bool {
+	return got == want || math.Abs(got-want) <= e.Epsilon
+}
+
+// RelativeVerify accepts results within a fraction of the expected value's magnitude, so a
+// Tolerance of 0.001 allows a difference of 0.1%. This suits large or widely varying magnitudes
+// where no single absolute epsilon works.
+type RelativeVerify struct {
+	Tolerance float64
+}
+
+// Verify reports whether got and want differ by no more than r.Tolerance times the larger of their
+// magnitudes
+func (r RelativeVerify) Verify(got, want float64) bool {
+	if got == want {
+		return true
+	}
+
+	return math.Abs(got-want) <= r.Tolerance*math.Max(math.Abs(got), math.Abs(want))
+}
@@ -0,0 +1,225 @@
+package calculator
+
+import (
+	"math"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// brokenCruncher is a NumberCruncher whose Add is off by one whenever x is negative
+type brokenCruncher struct {
+	Cruncher
+}
+
+func (brokenCruncher) Add(x, y float64) float64 {
+	if x < 0 {
+		return x + y + 1
+	}
+
+	return x + y
+}
+
+var addTable = []struct{ X, Y, Want float64 }{
+	{X: 0, Y: 0, Want: 0},
+	{X: -5, Y: -5, Want: -10},
+	{X: 0.1, Y: 0.2, Want: 0.3},
+	{X: -1, Y: 1, Want: 0},
+}
+
+func TestVerifyAddTable(t *testing.T) {
+	errs := VerifyAddTable(Cruncher{}, addTable, 1e-9)
+
+	assert.Empty(t, errs)
+}
+
+func TestVerifyAddTableBrokenImplementation(t *testing.T) {
+	errs := VerifyAddTable(brokenCruncher{}, addTable, 1e-9)
+
+	if assert.Len(t, errs, 2) {
+		assert.EqualError(t, errs[0], "case 1: Add(-5, -5) = -9, want -10 within 1e-09")
+		assert.EqualError(t, errs[1], "case 3: Add(-1, 1) = 1, want 0 within 1e-09")
+	}
+}
+
+func TestVerifyAddTableExactEpsilon(t *testing.T) {
+	errs := VerifyAddTable(Cruncher{}, addTable, 0)
+
+	// 0.1 + 0.2 is famously not exactly 0.3 in floating point
+	if assert.Len(t, errs, 1) {
+		assert.Contains(t, errs[0].Error(), "Add(0.1, 0.2)")
+	}
+}
+
+func TestCompare(t *testing.T) {
+	testCases := []struct {
+		name     string
+		a        float64
+		b        float64
+		expected int
+	}{
+		{name: "Less", a: 1, b: 2, expected: -1},
+		{name: "Greater", a: 2, b: 1, expected: 1},
+		{name: "Negative less", a: -5, b: -4.5, expected: -1},
+		{name: "Equal", a: 3, b: 3, expected: 0},
+		{name: "Within epsilon", a: 1.0000000001, b: 1, expected: 0},
+		{name: "Just within epsilon", a: 1, b: 1 + Epsilon/2, expected: 0},
+		{name: "Outside epsilon", a: 1, b: 1 + Epsilon*10, expected: -1},
+		{name: "Infinities", a: math.Inf(1), b: math.Inf(1), expected: 0},
+		{name: "Negative infinity", a: math.Inf(-1), b: 0, expected: -1},
+		{name: "NaN and number", a: math.NaN(), b: math.Inf(1), expected: 1},
+		{name: "Number and NaN", a: 1, b: math.NaN(), expected: -1},
+		{name: "NaN and NaN", a: math.NaN(), b: math.NaN(), expected: 0},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			assert.Equal(tt, tc.expected, Compare(tc.a, tc.b))
+		})
+	}
+}
+
+func TestCompareSort(t *testing.T) {
+	nums := []float64{3, math.NaN(), -1, math.Inf(1), 2}
+
+	sort.Slice(nums, func(i, j int) bool { return Compare(nums[i], nums[j]) < 0 })
+
+	assert.Equal(t, []float64{-1, 2, 3, math.Inf(1)}, nums[:4])
+	assert.True(t, math.IsNaN(nums[4]))
+}
+
+func TestVerifySlice(t *testing.T) {
+	nan := math.NaN()
+
+	testCases := []struct {
+		name     string
+		got      []float64
+		want     []float64
+		epsilon  float64
+		expected bool
+	}{
+		{name: "Equal", got: []float64{1, 2, 3}, want: []float64{1, 2, 3}, epsilon: 0, expected: true},
+		{name: "Both empty", got: []float64{}, want: nil, epsilon: 0, expected: true},
+		{name: "Length mismatch", got: []float64{1, 2}, want: []float64{1, 2, 3}, epsilon: 1, expected: false},
+		{name: "Near equal", got: []float64{1.0001, 2}, want: []float64{1, 2.0001}, epsilon: 0.001, expected: true},
+		{name: "One element differs", got: []float64{1, 2, 3.1}, want: []float64{1, 2, 3}, epsilon: 0.001, expected: false},
+		{name: "NaN in same position", got: []float64{1, nan}, want: []float64{1, nan}, epsilon: 0, expected: true},
+		{name: "NaN in one slice", got: []float64{1, nan}, want: []float64{1, 2}, epsilon: 1e9, expected: false},
+		{name: "NaN in the other slice", got: []float64{1, 2}, want: []float64{1, nan}, epsilon: 1e9, expected: false},
+		{name: "Matching infinities", got: []float64{math.Inf(1)}, want: []float64{math.Inf(1)}, epsilon: 0, expected: true},
+		{name: "Opposite infinities", got: []float64{math.Inf(1)}, want: []float64{math.Inf(-1)}, epsilon: 1, expected: false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			assert.Equal(tt, tc.expected, VerifySlice(tc.got, tc.want, tc.epsilon))
+		})
+	}
+}
+
+func TestVerifyDetailed(t *testing.T) {
+	testCases := []struct {
+		name    string
+		got     float64
+		want    float64
+		epsilon float64
+		ok      bool
+		diff    float64
+		msg     string
+	}{
+		{name: "Exact", got: 2, want: 2, epsilon: 0, ok: true, diff: 0},
+		{name: "Within epsilon", got: 3.1415, want: 3.1416, epsilon: 0.001, ok: true, diff: -0.0001},
+		{name: "Too high", got: 3.14, want: 3.1, epsilon: 0.001, ok: false, diff: 0.04, msg: "got 3.14, want 3.1, diff 0.04 exceeds epsilon 0.001"},
+		{name: "Too low", got: 9, want: 10, epsilon: 0.5, ok: false, diff: -1, msg: "got 9, want 10, diff -1 exceeds epsilon 0.5"},
+		{name: "NaN", got: math.NaN(), want: 1, epsilon: 1, ok: false, msg: "got NaN, want 1, diff NaN exceeds epsilon 1"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			ok, diff, msg := VerifyDetailed(tc.got, tc.want, tc.epsilon)
+
+			assert.Equal(tt, tc.ok, ok)
+			assert.Equal(tt, tc.msg, msg)
+			if !math.IsNaN(tc.got) {
+				assert.InDelta(tt, tc.diff, diff, 1e-12)
+			}
+		})
+	}
+}
+
+func TestEqualULP(t *testing.T) {
+	negZero := math.Copysign(0, -1)
+	smallest := math.SmallestNonzeroFloat64
+
+	testCases := []struct {
+		name     string
+		a        float64
+		b        float64
+		maxULPs  uint
+		expected bool
+	}{
+		{name: "Equal", a: 1.5, b: 1.5, maxULPs: 0, expected: true},
+		{name: "1 ULP apart", a: 1, b: math.Nextafter(1, 2), maxULPs: 1, expected: true},
+		{name: "1 ULP apart reversed", a: math.Nextafter(1, 2), b: 1, maxULPs: 1, expected: true},
+		{name: "1 ULP apart with 0 allowed", a: 1, b: math.Nextafter(1, 2), maxULPs: 0, expected: false},
+		{name: "2 ULPs apart at 1", a: 1, b: math.Nextafter(math.Nextafter(1, 2), 2), maxULPs: 1, expected: false},
+		{name: "2 ULPs apart at 2", a: 1, b: math.Nextafter(math.Nextafter(1, 2), 2), maxULPs: 2, expected: true},
+		{name: "0.1 + 0.2", a: 0.1 + 0.2, b: 0.3, maxULPs: 1, expected: true},
+		{name: "Large magnitude", a: 1e300, b: math.Nextafter(1e300, math.Inf(1)), maxULPs: 1, expected: true},
+		{name: "Large magnitude far apart", a: 1e300, b: 1.0000001e300, maxULPs: 1000, expected: false},
+		{name: "Negative", a: -1, b: math.Nextafter(-1, 0), maxULPs: 1, expected: true},
+		{name: "Zeros", a: 0, b: negZero, maxULPs: 0, expected: true},
+		{name: "Across zero", a: smallest, b: -smallest, maxULPs: 2, expected: true},
+		{name: "Across zero too far", a: smallest, b: -smallest, maxULPs: 1, expected: false},
+		{name: "Opposite signs", a: 1, b: -1, maxULPs: math.MaxUint32, expected: false},
+		{name: "Infinities", a: math.Inf(1), b: math.Inf(1), maxULPs: 0, expected: true},
+		{name: "Opposite infinities", a: math.Inf(1), b: math.Inf(-1), maxULPs: math.MaxUint32, expected: false},
+		{name: "NaN", a: math.NaN(), b: math.NaN(), maxULPs: math.MaxUint32, expected: false},
+		{name: "NaN and number", a: math.NaN(), b: 1, maxULPs: math.MaxUint32, expected: false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			assert.Equal(tt, tc.expected, EqualULP(tc.a, tc.b, tc.maxULPs))
+		})
+	}
+}
+
+func TestVerifyStrategies(t *testing.T) {
+	testCases := []struct {
+		name     string
+		strategy VerifyStrategy
+		got      float64
+		want     float64
+		expected bool
+	}{
+		{name: "Exact equal", strategy: ExactVerify{}, got: 2.5, want: 2.5, expected: true},
+		{name: "Exact off by a bit", strategy: ExactVerify{}, got: 1, want: math.Nextafter(1, 2), expected: false},
+		{name: "Epsilon within", strategy: EpsilonVerify{Epsilon: 0.01}, got: 1.005, want: 1, expected: true},
+		{name: "Epsilon outside", strategy: EpsilonVerify{Epsilon: 0.01}, got: 1.02, want: 1, expected: false},
+		{name: "Epsilon infinities", strategy: EpsilonVerify{Epsilon: 0.01}, got: math.Inf(1), want: math.Inf(1), expected: true},
+		{name: "Relative large magnitude", strategy: RelativeVerify{Tolerance: 0.001}, got: 1000000, want: 1000001, expected: true},
+		{name: "Tiny epsilon large magnitude", strategy: EpsilonVerify{Epsilon: 1e-9}, got: 1000000, want: 1000001, expected: false},
+		{name: "Relative outside", strategy: RelativeVerify{Tolerance: 0.001}, got: 100, want: 101, expected: false},
+		{name: "Relative both zero", strategy: RelativeVerify{Tolerance: 0.001}, got: 0, want: 0, expected: true},
+		{name: "Relative against zero", strategy: RelativeVerify{Tolerance: 0.001}, got: 1e-12, want: 0, expected: false},
+		{name: "Exact NaN", strategy: ExactVerify{}, got: math.NaN(), want: math.NaN(), expected: false},
+		{name: "Epsilon NaN", strategy: EpsilonVerify{Epsilon: 1}, got: math.NaN(), want: 1, expected: false},
+		{name: "Relative NaN", strategy: RelativeVerify{Tolerance: 1}, got: 1, want: math.NaN(), expected: false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			assert.Equal(tt, tc.expected, tc.strategy.Verify(tc.got, tc.want))
+		})
+	}
+}
+
+func TestCalculatorVerifyStrategy(t *testing.T) {
+	exact := NewCalculator()
+	relative := NewCalculator(WithVerifyStrategy(RelativeVerify{Tolerance: 1e-6}))
+
+	for _, c := range []*Calculator{exact, relative} {
+		c.Add(0.1)
+		c.Add(0.2)
+	}
+
+	assert.False(t, exact.Verify(0.3))
+	assert.True(t, relative.Verify(0.3))
+}